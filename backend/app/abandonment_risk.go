@@ -0,0 +1,132 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type AbandonmentRisk struct {
+	// Score goes from 0, recently updated and responsive, to 100
+	Score               int       `json:"score"`
+	LastUpdatedAt       time.Time `json:"lastUpdatedAt"`
+	DaysSinceLastUpdate int       `json:"daysSinceLastUpdate"`
+	// IsAbandoned is set if the mod has not been updated in 18 months
+	IsAbandoned bool `json:"isAbandoned"`
+}
+
+const (
+	abandonedThreshold = 18 * 30 * 24 * time.Hour
+	// The issue response rate only counts for this much of the score, the time since the last update is the rest
+	issueResponseWeight      = 30
+	issueResponseSampleSize  = 30
+	abandonmentRiskMaxPoints = 10
+)
+
+var abandonmentRiskCache = utils.NewTTLCache[string, AbandonmentRisk](24 * time.Hour)
+
+// fetchGitHubIssueResponseRate returns the share of the repository's most recent issues that were commented on or closed.
+// The issues API lists pull requests too, those are not counted. The request uses the GitHub token, if one is set.
+func fetchGitHubIssueResponseRate(repoPath string) (float64, bool, error) {
+	var issues []struct {
+		State       string    `json:"state"`
+		Comments    int       `json:"comments"`
+		PullRequest *struct{} `json:"pull_request"`
+	}
+	err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/issues?state=all&sort=created&per_page=%d", repoPath, issueResponseSampleSize), &issues)
+	if err != nil {
+		return 0, false, err
+	}
+	total, responded := 0, 0
+	for _, issue := range issues {
+		if issue.PullRequest != nil {
+			continue
+		}
+		total++
+		if issue.Comments > 0 || issue.State == "closed" {
+			responded++
+		}
+	}
+	if total == 0 {
+		return 0, false, nil
+	}
+	return float64(responded) / float64(total), true, nil
+}
+
+// modIssueResponseRate is only known for mods with a GitHub issue tracker that has issues.
+// An error means the rate could not be fetched, not that the mod has none.
+func (a *app) modIssueResponseRate(modID string) (float64, bool, error) {
+	issueTrackerURL, err := a.GetModIssueTrackerURL(modID)
+	if err != nil {
+		if errors.Is(err, ErrNoIssueTrackerURL) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	repo, ok := parseSourceRepository(issueTrackerURL)
+	if !ok || repo.Host != repositoryHostGitHub {
+		return 0, false, nil
+	}
+	return fetchGitHubIssueResponseRate(repo.Path)
+}
+
+// GetModAbandonmentRisk estimates how likely a mod is to no longer be maintained,
+// from the time since its last release and how many of its recent GitHub issues got a response.
+func (a *app) GetModAbandonmentRisk(modID string) (AbandonmentRisk, error) {
+	if modID == "" {
+		return AbandonmentRisk{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	if risk, ok := abandonmentRiskCache.Get(modID); ok {
+		return risk, nil
+	}
+
+	data, err := getModCompatibilityData(modID)
+	if err != nil {
+		return AbandonmentRisk{}, err
+	}
+	if data.LastVersionDate == nil {
+		return AbandonmentRisk{}, fmt.Errorf("mod %s has no versions", modID)
+	}
+	sinceUpdate := time.Since(*data.LastVersionDate)
+	risk := AbandonmentRisk{
+		LastUpdatedAt:       *data.LastVersionDate,
+		DaysSinceLastUpdate: int(sinceUpdate.Hours() / 24),
+		IsAbandoned:         sinceUpdate >= abandonedThreshold,
+	}
+
+	recencyRisk := min(100, 100*float64(sinceUpdate)/float64(abandonedThreshold))
+	responseRate, ok, err := a.modIssueResponseRate(modID)
+	if ok {
+		risk.Score = int(recencyRisk*(100-issueResponseWeight)/100 + (1-responseRate)*issueResponseWeight)
+	} else {
+		risk.Score = int(recencyRisk)
+	}
+	if err != nil {
+		// Without the response rate the score is incomplete, so it is not cached and the next request tries again
+		slog.Warn("failed to get issue response rate", slog.String("mod", modID), slog.Any("error", err))
+		return risk, nil
+	}
+	abandonmentRiskCache.Set(modID, risk)
+	return risk, nil
+}
+
+func (a *app) abandonmentRiskFactor(modID string) (ScoreFactor, bool) {
+	risk, err := a.GetModAbandonmentRisk(modID)
+	if err != nil {
+		slog.Warn("failed to get mod abandonment risk", slog.String("mod", modID), slog.Any("error", err))
+		return ScoreFactor{}, false
+	}
+	factor := ScoreFactor{
+		Name:      "Maintenance",
+		Points:    abandonmentRiskMaxPoints * (100 - risk.Score) / 100,
+		MaxPoints: abandonmentRiskMaxPoints,
+		Reason:    fmt.Sprintf("Abandonment risk %d/100", risk.Score),
+	}
+	if risk.IsAbandoned {
+		factor.Reason = fmt.Sprintf("Not updated in %d days, likely abandoned", risk.DaysSinceLastUpdate)
+	}
+	return factor, true
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type ActivityEvent struct {
+	ModID string `json:"modId"`
+	// Type is always release, ficsit.app has no comments or other activity
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	EventTime   time.Time `json:"eventTime"`
+}
+
+const activityFeedMaxAge = 90 * 24 * time.Hour
+
+const getModsRecentVersionsQuery = `query GetModsRecentVersions($filter: ModFilter) {
+  getMods(filter: $filter) {
+    mods {
+      mod_reference
+      name
+      versions(filter: { limit: 10, order_by: created_at, order: desc }) {
+        id
+        version
+        created_at
+      }
+    }
+  }
+}`
+
+type activityFeedCacheKey struct {
+	modID string
+	// day is the local date the feed was fetched on, so the max age cutoff moves every day
+	day string
+}
+
+var activityFeedCache = utils.NewTTLCache[activityFeedCacheKey, []ActivityEvent](1 * time.Hour)
+
+func fetchModsActivity(modReferences []string, since time.Time) (map[string][]ActivityEvent, error) {
+	result := make(map[string][]ActivityEvent, len(modReferences))
+	for start := 0; start < len(modReferences); start += ficsitAPIMaxLimit {
+		batch := modReferences[start:min(start+ficsitAPIMaxLimit, len(modReferences))]
+		var response struct {
+			GetMods struct {
+				Mods []struct {
+					ModReference string `json:"mod_reference"`
+					Name         string `json:"name"`
+					Versions     []struct {
+						ID        string    `json:"id"`
+						Version   string    `json:"version"`
+						CreatedAt time.Time `json:"created_at"`
+					} `json:"versions"`
+				} `json:"mods"`
+			} `json:"getMods"`
+		}
+		err := queryFicsitAPI(getModsRecentVersionsQuery, map[string]interface{}{
+			"filter": map[string]interface{}{
+				"references": batch,
+				"limit":      len(batch),
+			},
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range response.GetMods.Mods {
+			events := make([]ActivityEvent, 0, len(mod.Versions))
+			for _, version := range mod.Versions {
+				if version.CreatedAt.Before(since) {
+					continue
+				}
+				events = append(events, ActivityEvent{
+					ModID:       mod.ModReference,
+					Type:        "release",
+					Description: fmt.Sprintf("%s %s released", mod.Name, version.Version),
+					URL:         fmt.Sprintf("%s/mod/%s/version/%s", App.GetSiteEndpoint(), mod.ModReference, version.ID),
+					EventTime:   version.CreatedAt,
+				})
+			}
+			result[mod.ModReference] = events
+		}
+		// Mods that are not on ficsit.app have no activity
+		for _, modReference := range batch {
+			if _, ok := result[modReference]; !ok {
+				result[modReference] = []ActivityEvent{}
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetModActivityFeed returns the releases of the mods from the last 90 days, newest first, at most 10 per mod
+func (a *app) GetModActivityFeed(modIDs []string) ([]ActivityEvent, error) {
+	now := time.Now()
+	day := now.Format(time.DateOnly)
+
+	feed := make([]ActivityEvent, 0)
+	missing := make([]string, 0, len(modIDs))
+	for _, modID := range lo.Uniq(modIDs) {
+		if events, ok := activityFeedCache.Get(activityFeedCacheKey{modID, day}); ok {
+			feed = append(feed, events...)
+			continue
+		}
+		missing = append(missing, modID)
+	}
+	if len(missing) > 0 {
+		fetched, err := fetchModsActivity(missing, now.Add(-activityFeedMaxAge))
+		if err != nil {
+			return nil, err
+		}
+		for modID, events := range fetched {
+			activityFeedCache.Set(activityFeedCacheKey{modID, day}, events)
+			feed = append(feed, events...)
+		}
+	}
+
+	slices.SortFunc(feed, func(a, b ActivityEvent) int {
+		return b.EventTime.Compare(a.EventTime)
+	})
+	return feed, nil
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const getUserModsQuery = `query GetUserMods($userID: UserID!) {
+  getUser(userId: $userID) {
+    mods {
+      mod {` + ficsitModFields + `}
+    }
+  }
+}`
+
+type authorModsCacheKey struct {
+	authorID string
+	page     int
+	pageSize int
+}
+
+var authorModsCache = utils.NewTTLCache[authorModsCacheKey, SearchResult](30 * time.Minute)
+
+// ficsit.app's ModFilter cannot filter by author, and a user's mods are not paginated,
+// so the pages are built from the full list of the author's mods
+func (a *app) GetModsByAuthor(authorID string, page, pageSize int) (SearchResult, error) {
+	if authorID == "" {
+		return SearchResult{}, fmt.Errorf("author ID cannot be empty")
+	}
+	page, pageSize = normalizePagination(page, pageSize)
+
+	result, err := authorModsCache.GetOrCompute(authorModsCacheKey{authorID, page, pageSize}, func() (SearchResult, error) {
+		var response struct {
+			User *struct {
+				Mods []struct {
+					Mod ficsitMod `json:"mod"`
+				} `json:"mods"`
+			} `json:"getUser"`
+		}
+		err := queryFicsitAPI(getUserModsQuery, map[string]interface{}{
+			"userID": authorID,
+		}, &response)
+		if err != nil {
+			return SearchResult{}, err
+		}
+		if response.User == nil {
+			return SearchResult{}, fmt.Errorf("author %s not found", authorID)
+		}
+
+		mods := make([]ficsitMod, 0, len(response.User.Mods))
+		for _, userMod := range response.User.Mods {
+			modDataCache.Set(userMod.Mod.ModReference, userMod.Mod)
+			mods = append(mods, userMod.Mod)
+		}
+
+		start := min(page*pageSize, len(mods))
+		end := min(start+pageSize, len(mods))
+		return SearchResult{
+			Mods:       toModSummaries(mods[start:end]),
+			TotalCount: len(mods),
+			Page:       page,
+			PageSize:   pageSize,
+		}, nil
+	})
+	if err != nil {
+		return SearchResult{}, err
+	}
+	result.Mods = withUserState(result.Mods, true)
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+const modAutoUpdateInterval = 30 * time.Minute
+
+func (a *app) GetAutoUpdateSettings() (settings.AutoUpdateSettings, error) {
+	return settings.Settings.AutoUpdate, nil
+}
+
+func (a *app) SetAutoUpdateSettings(autoUpdate settings.AutoUpdateSettings) error {
+	for _, offset := range []time.Duration{autoUpdate.AutoUpdateWindowStart, autoUpdate.AutoUpdateWindowEnd} {
+		if offset < 0 || offset >= 24*time.Hour {
+			return fmt.Errorf("auto update window must be within a day, got %s", offset)
+		}
+	}
+	settings.Settings.AutoUpdate = autoUpdate
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save auto update settings: %w", err)
+	}
+	return nil
+}
+
+// StartModAutoUpdater periodically updates SML and the mods of the selected profile, as allowed by the auto update settings
+func (a *app) StartModAutoUpdater() {
+	ticker := time.NewTicker(modAutoUpdateInterval)
+	go func() {
+		for range ticker.C {
+			autoUpdateMods()
+		}
+	}()
+}
+
+func autoUpdateMods() {
+	autoUpdate := settings.Settings.AutoUpdate
+	if !autoUpdate.UpdateSMLAutomatically && !autoUpdate.UpdateModsAutomatically {
+		return
+	}
+	if !autoUpdate.InWindow(time.Now()) {
+		return
+	}
+	// Mod files cannot be replaced while the game has them loaded
+	if ficsitcli.FicsitCLI.IsGameRunning() {
+		return
+	}
+
+	updates, err := ficsitcli.FicsitCLI.CheckForUpdates()
+	if err != nil {
+		slog.Warn("failed to check for mod updates", slog.Any("error", err))
+		return
+	}
+	toUpdate := make([]string, 0, len(updates))
+	for _, update := range updates {
+		isSML := update.Item == "SML"
+		if (isSML && autoUpdate.UpdateSMLAutomatically) || (!isSML && autoUpdate.UpdateModsAutomatically) {
+			toUpdate = append(toUpdate, update.Item)
+		}
+	}
+	if len(toUpdate) == 0 {
+		return
+	}
+
+	slog.Info("automatically updating mods", slog.Any("mods", toUpdate))
+	err = ficsitcli.FicsitCLI.UpdateMods(toUpdate)
+	if err != nil {
+		slog.Warn("failed to automatically update mods", slog.Any("error", err))
+	}
+}
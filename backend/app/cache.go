@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+const minCacheSize = 100 * 1024 * 1024
+
+func (a *app) GetMaxCacheSize() int64 {
+	return settings.Settings.MaxCacheSize
+}
+
+// SetMaxCacheSize sets the size in bytes the download cache is kept under, evicting the least recently used archives if it is already larger
+func (a *app) SetMaxCacheSize(bytes int64) error {
+	if bytes < minCacheSize {
+		return fmt.Errorf("the cache size must be at least %d MB", minCacheSize/1024/1024)
+	}
+	settings.Settings.MaxCacheSize = bytes
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save cache size: %w", err)
+	}
+	go func() {
+		err := ficsitcli.FicsitCLI.EvictDownloadCache()
+		if err != nil {
+			slog.Warn("failed to evict download cache", slog.Any("error", err))
+		}
+	}()
+	return nil
+}
+
+// GetModCacheHitRate returns the share of mod archives found in the download cache since startup, or since ResetCacheStats
+func (a *app) GetModCacheHitRate() (float64, error) {
+	return ficsitcli.FicsitCLI.GetCacheStats().HitRate, nil
+}
+
+func (a *app) ResetCacheStats() error {
+	ficsitcli.FicsitCLI.ResetCacheStats()
+	return nil
+}
@@ -0,0 +1,234 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/ficsit-cli/cli"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+var (
+	ErrCloudSyncNotConfigured = fmt.Errorf("cloud sync endpoint is not configured")
+	ErrCloudSyncConflict      = fmt.Errorf("the profile was changed both locally and in the cloud since the last sync")
+)
+
+type SyncStatus struct {
+	LastSyncedAt time.Time `json:"lastSyncedAt"`
+	IsConflict   bool      `json:"isConflict"`
+	// RemoteVersion is the ETag of the profile in the cloud, or empty if it has not been uploaded
+	RemoteVersion string `json:"remoteVersion"`
+}
+
+type cloudProfile struct {
+	Name string                    `json:"name"`
+	Mods map[string]cli.ProfileMod `json:"mods"`
+}
+
+func cloudProfileURL(profileName string) (string, error) {
+	endpoint := settings.Settings.CloudSyncEndpoint
+	if endpoint == "" {
+		return "", ErrCloudSyncNotConfigured
+	}
+	if _, err := validateHTTPSURL(endpoint); err != nil {
+		return "", fmt.Errorf("invalid cloud sync endpoint: %w", err)
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/" + url.PathEscape(profileName) + ".json", nil
+}
+
+func cloudSyncRequest(method, profileName string, body []byte, headers map[string]string) (*http.Response, error) {
+	profileURL, err := cloudProfileURL(profileName)
+	if err != nil {
+		return nil, err
+	}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequest(method, profileURL, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	credentials := settings.GetCloudSyncCredentials()
+	if credentials.Username != "" {
+		request.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach cloud sync endpoint: %w", err)
+	}
+	return response, nil
+}
+
+func hashProfileMods(mods map[string]cli.ProfileMod) (string, error) {
+	// Maps are marshaled with sorted keys, so the same mods always give the same hash
+	data, err := json.Marshal(mods)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize profile: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func getRemoteProfileVersion(profileName string) (string, error) {
+	response, err := cloudSyncRequest(http.MethodHead, profileName, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cloud sync endpoint returned status %s", response.Status)
+	}
+	return response.Header.Get("ETag"), nil
+}
+
+func (a *app) GetProfileSyncStatus(profileName string) (SyncStatus, error) {
+	profile := ficsitcli.FicsitCLI.GetProfile(profileName)
+	if profile == nil {
+		return SyncStatus{}, fmt.Errorf("profile %s does not exist", profileName)
+	}
+	remoteVersion, err := getRemoteProfileVersion(profileName)
+	if err != nil {
+		return SyncStatus{}, err
+	}
+	status := SyncStatus{
+		RemoteVersion: remoteVersion,
+	}
+	state, ok := settings.Settings.CloudSyncState[profileName]
+	if !ok {
+		return status, nil
+	}
+	status.LastSyncedAt = state.LastSyncedAt
+	localHash, err := hashProfileMods(profile.Mods)
+	if err != nil {
+		return SyncStatus{}, err
+	}
+	status.IsConflict = remoteVersion != state.ETag && localHash != state.ContentHash
+	return status, nil
+}
+
+func saveCloudSyncState(profileName, etag string, mods map[string]cli.ProfileMod) error {
+	hash, err := hashProfileMods(mods)
+	if err != nil {
+		return err
+	}
+	settings.Settings.CloudSyncState[profileName] = settings.CloudSyncProfileState{
+		LastSyncedAt: time.Now(),
+		ETag:         etag,
+		ContentHash:  hash,
+	}
+	err = settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save sync state: %w", err)
+	}
+	return nil
+}
+
+func (a *app) SyncProfileToCloud(profileName string) error {
+	status, err := a.GetProfileSyncStatus(profileName)
+	if err != nil {
+		return err
+	}
+	if status.IsConflict {
+		return ErrCloudSyncConflict
+	}
+
+	profile := ficsitcli.FicsitCLI.GetProfile(profileName)
+	data, err := json.Marshal(cloudProfile{
+		Name: profileName,
+		Mods: profile.Mods,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to serialize profile: %w", err)
+	}
+
+	// Guards against the profile being uploaded from another PC between the status check and this upload
+	headers := map[string]string{"Content-Type": "application/json"}
+	if status.RemoteVersion != "" {
+		headers["If-Match"] = status.RemoteVersion
+	} else {
+		headers["If-None-Match"] = "*"
+	}
+	response, err := cloudSyncRequest(http.MethodPut, profileName, data, headers)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusPreconditionFailed {
+		return ErrCloudSyncConflict
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("failed to upload profile: %s", response.Status)
+	}
+
+	etag := response.Header.Get("ETag")
+	if etag == "" {
+		// Not all WebDAV servers return the new ETag on upload
+		etag, err = getRemoteProfileVersion(profileName)
+		if err != nil {
+			return err
+		}
+	}
+	return saveCloudSyncState(profileName, etag, profile.Mods)
+}
+
+// FetchProfileFromCloud replaces the local profile with the one in the cloud, creating it if needed.
+// Unless overwriteLocalChanges is set, it fails with ErrCloudSyncConflict if that would lose local changes not yet synced
+func (a *app) FetchProfileFromCloud(profileName string, overwriteLocalChanges bool) error {
+	response, err := cloudSyncRequest(http.MethodGet, profileName, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download profile: %s", response.Status)
+	}
+
+	var remote cloudProfile
+	err = json.NewDecoder(response.Body).Decode(&remote)
+	if err != nil {
+		return fmt.Errorf("failed to decode profile: %w", err)
+	}
+	if remote.Mods == nil {
+		remote.Mods = map[string]cli.ProfileMod{}
+	}
+
+	localProfile := ficsitcli.FicsitCLI.GetProfile(profileName)
+	if localProfile != nil && !overwriteLocalChanges {
+		localHash, err := hashProfileMods(localProfile.Mods)
+		if err != nil {
+			return err
+		}
+		remoteHash, err := hashProfileMods(remote.Mods)
+		if err != nil {
+			return err
+		}
+		state, synced := settings.Settings.CloudSyncState[profileName]
+		// A profile that was never synced, or was changed since the last sync, has local changes
+		if localHash != remoteHash && (!synced || localHash != state.ContentHash) {
+			return ErrCloudSyncConflict
+		}
+	}
+
+	err = ficsitcli.FicsitCLI.ReplaceProfileMods(profileName, remote.Mods)
+	if err != nil {
+		return err
+	}
+	return saveCloudSyncState(profileName, response.Header.Get("ETag"), remote.Mods)
+}
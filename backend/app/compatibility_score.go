@@ -0,0 +1,285 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type ScoreFactor struct {
+	Name      string `json:"name"`
+	Points    int    `json:"points"`
+	MaxPoints int    `json:"maxPoints"`
+	Reason    string `json:"reason"`
+}
+
+// CompatibilityScore only includes the factors that could be evaluated, MaxScore is the sum of their MaxPoints
+type CompatibilityScore struct {
+	Score    int           `json:"score"`
+	MaxScore int           `json:"maxScore"`
+	Factors  []ScoreFactor `json:"factors"`
+}
+
+const (
+	gameVersionMaxPoints      = 30
+	smlVersionMaxPoints       = 20
+	updateActivityMaxPoints   = 20
+	reportedCompatMaxPoints   = 20
+	openBugsMaxPoints         = 10
+	recentUpdateThreshold     = 90 * 24 * time.Hour
+	maintainedUpdateThreshold = 365 * 24 * time.Hour
+	fewOpenBugsThreshold      = 5
+)
+
+const getModCompatibilityQuery = `query GetModCompatibility($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    last_version_date
+    compatibility {
+      EA {
+        state
+      }
+      EXP {
+        state
+      }
+    }
+  }
+}`
+
+type ficsitCompatibilityInfo struct {
+	State string `json:"state"`
+}
+
+type modCompatibilityData struct {
+	LastVersionDate *time.Time `json:"last_version_date"`
+	Compatibility   *struct {
+		EA  *ficsitCompatibilityInfo `json:"EA"`
+		EXP *ficsitCompatibilityInfo `json:"EXP"`
+	} `json:"compatibility"`
+}
+
+var modCompatibilityDataCache = utils.NewTTLCache[string, modCompatibilityData](1 * time.Hour)
+
+func getModCompatibilityData(modID string) (modCompatibilityData, error) {
+	return modCompatibilityDataCache.GetOrCompute(modID, func() (modCompatibilityData, error) {
+		var response struct {
+			Mod *modCompatibilityData `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModCompatibilityQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return modCompatibilityData{}, err
+		}
+		if response.Mod == nil {
+			return modCompatibilityData{}, fmt.Errorf("mod %s not found", modID)
+		}
+		return *response.Mod, nil
+	})
+}
+
+func gameVersionFactor(gameVersionConstraint string, gameVersion int) (ScoreFactor, bool) {
+	if gameVersionConstraint == "" {
+		return ScoreFactor{}, false
+	}
+	constraint, err := semver.NewConstraint(gameVersionConstraint)
+	if err != nil {
+		return ScoreFactor{}, false
+	}
+	version, err := parseGameVersion(strconv.Itoa(gameVersion))
+	if err != nil {
+		return ScoreFactor{}, false
+	}
+	factor := ScoreFactor{Name: "Game version", MaxPoints: gameVersionMaxPoints}
+	if constraint.Check(version) {
+		factor.Points = gameVersionMaxPoints
+		factor.Reason = fmt.Sprintf("Supports game version %d", gameVersion)
+	} else {
+		factor.Reason = fmt.Sprintf("Requires game version %s, the installation has %d", gameVersionConstraint, gameVersion)
+	}
+	return factor, true
+}
+
+// smlVersionFactor compares against the installed SML, or the SML that would be installed if there is none yet
+func (a *app) smlVersionFactor(smlCondition string) (ScoreFactor, bool) {
+	factor := ScoreFactor{Name: "SML version", MaxPoints: smlVersionMaxPoints}
+	if smlCondition == "" {
+		factor.Points = smlVersionMaxPoints
+		factor.Reason = "Does not depend on SML"
+		return factor, true
+	}
+	constraint, err := semver.NewConstraint(smlCondition)
+	if err != nil {
+		return ScoreFactor{}, false
+	}
+
+	var smlVersion string
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err == nil {
+		if lockedSML, ok := lockfileMods["SML"]; ok {
+			smlVersion = lockedSML.Version
+		}
+	}
+	if smlVersion == "" {
+		smlVersion, err = a.GetLatestCompatibleSMLVersion()
+		if err != nil {
+			return ScoreFactor{}, false
+		}
+	}
+	version, err := semver.NewVersion(smlVersion)
+	if err != nil {
+		return ScoreFactor{}, false
+	}
+	if constraint.Check(version) {
+		factor.Points = smlVersionMaxPoints
+		factor.Reason = fmt.Sprintf("Works with SML %s", smlVersion)
+	} else {
+		factor.Reason = fmt.Sprintf("Requires SML %s, not %s", smlCondition, smlVersion)
+	}
+	return factor, true
+}
+
+func updateActivityFactor(lastVersionDate *time.Time) (ScoreFactor, bool) {
+	if lastVersionDate == nil {
+		return ScoreFactor{}, false
+	}
+	factor := ScoreFactor{Name: "Update activity", MaxPoints: updateActivityMaxPoints}
+	sinceUpdate := time.Since(*lastVersionDate)
+	switch {
+	case sinceUpdate < recentUpdateThreshold:
+		factor.Points = updateActivityMaxPoints
+	case sinceUpdate < maintainedUpdateThreshold:
+		factor.Points = updateActivityMaxPoints / 2
+	}
+	factor.Reason = fmt.Sprintf("Last updated %d days ago", int(sinceUpdate.Hours()/24))
+	return factor, true
+}
+
+// reportedCompatibilityFactor uses the compatibility reported on ficsit.app for the installation's branch.
+// ficsit.app has no user ratings, these reports are the closest it has.
+func reportedCompatibilityFactor(data modCompatibilityData, branch common.GameBranch) (ScoreFactor, bool) {
+	if data.Compatibility == nil {
+		return ScoreFactor{}, false
+	}
+	info := data.Compatibility.EA
+	if branch == common.BranchExperimental {
+		info = data.Compatibility.EXP
+	}
+	if info == nil {
+		return ScoreFactor{}, false
+	}
+	factor := ScoreFactor{Name: "Reported compatibility", MaxPoints: reportedCompatMaxPoints}
+	switch strings.ToLower(info.State) {
+	case "works":
+		factor.Points = reportedCompatMaxPoints
+	case "damaged":
+		factor.Points = reportedCompatMaxPoints / 2
+	case "broken":
+	default:
+		return ScoreFactor{}, false
+	}
+	factor.Reason = fmt.Sprintf("Reported as %s on %s", strings.ToLower(info.State), branch)
+	return factor, true
+}
+
+// openBugsFactor needs a GitHub token and a GitHub repository
+func (a *app) openBugsFactor(modID string) (ScoreFactor, bool) {
+	if settings.GetGitHubToken() == "" {
+		return ScoreFactor{}, false
+	}
+	issueTrackerURL, err := a.GetModIssueTrackerURL(modID)
+	if err != nil {
+		return ScoreFactor{}, false
+	}
+	repo, ok := parseSourceRepository(issueTrackerURL)
+	if !ok || repo.Host != repositoryHostGitHub {
+		return ScoreFactor{}, false
+	}
+	openBugCount, err := openBugCountCache.GetOrCompute(repo.Path, func() (int, error) {
+		return fetchGitHubOpenBugCount(repo.Path)
+	})
+	if err != nil {
+		if !errors.Is(err, errGitHubRateLimited) {
+			slog.Warn("failed to get open bug count", slog.String("mod", modID), slog.Any("error", err))
+		}
+		return ScoreFactor{}, false
+	}
+	factor := ScoreFactor{
+		Name:      "Open bugs",
+		MaxPoints: openBugsMaxPoints,
+		Reason:    fmt.Sprintf("%d open bug reports", openBugCount),
+	}
+	switch {
+	case openBugCount == 0:
+		factor.Points = openBugsMaxPoints
+	case openBugCount <= fewOpenBugsThreshold:
+		factor.Points = openBugsMaxPoints / 2
+	}
+	return factor, true
+}
+
+// GetModCompatibilityScore rates how likely a mod version is to work with the selected installation.
+// An empty version means the installed version.
+func (a *app) GetModCompatibilityScore(modID, version string) (CompatibilityScore, error) {
+	if modID == "" {
+		return CompatibilityScore{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	if version == "" {
+		var err error
+		version, err = installedModVersion(modID)
+		if err != nil {
+			return CompatibilityScore{}, err
+		}
+	}
+	modVersion, err := ficsitcli.FicsitCLI.GetModVersion(modID, version)
+	if err != nil {
+		return CompatibilityScore{}, fmt.Errorf("failed to get mod version: %w", err)
+	}
+
+	factors := make([]ScoreFactor, 0, 6)
+	addFactor := func(factor ScoreFactor, ok bool) {
+		if ok {
+			factors = append(factors, factor)
+		}
+	}
+
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info != nil {
+		addFactor(gameVersionFactor(modVersion.GameVersion, meta.Info.Version))
+	}
+	var smlCondition string
+	for _, dependency := range modVersion.Dependencies {
+		if dependency.ModID == "SML" {
+			smlCondition = dependency.Condition
+		}
+	}
+	addFactor(a.smlVersionFactor(smlCondition))
+
+	data, err := getModCompatibilityData(modID)
+	if err != nil {
+		slog.Warn("failed to get mod compatibility data", slog.String("mod", modID), slog.Any("error", err))
+	} else {
+		addFactor(updateActivityFactor(data.LastVersionDate))
+		if meta.Info != nil {
+			addFactor(reportedCompatibilityFactor(data, meta.Info.Branch))
+		}
+	}
+	addFactor(a.openBugsFactor(modID))
+	addFactor(a.abandonmentRiskFactor(modID))
+
+	score := CompatibilityScore{Factors: factors}
+	for _, factor := range factors {
+		score.Score += factor.Points
+		score.MaxScore += factor.MaxPoints
+	}
+	return score, nil
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type CompatibilityRow struct {
+	ModVersion string `json:"modVersion"`
+	// GameVersion and SMLVersion are the version constraints of the mod version, empty if it has none
+	GameVersion  string `json:"gameVersion"`
+	SMLVersion   string `json:"smlVersion"`
+	IsCompatible bool   `json:"isCompatible"`
+	Notes        string `json:"notes"`
+}
+
+type CompatibilityTable struct {
+	Rows []CompatibilityRow `json:"rows"`
+}
+
+// Keyed by mod reference, the rows without the compatibility with the selected installation
+var modVersionConstraintsCache = utils.NewTTLCache[string, []CompatibilityRow](30 * time.Minute)
+
+func getModVersionConstraints(modID string) ([]CompatibilityRow, error) {
+	return modVersionConstraintsCache.GetOrCompute(modID, func() ([]CompatibilityRow, error) {
+		modVersions, err := ficsitcli.FicsitCLI.GetModVersions(modID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mod versions: %w", err)
+		}
+		rows := make([]CompatibilityRow, 0, len(modVersions))
+		for _, modVersion := range modVersions {
+			row := CompatibilityRow{
+				ModVersion:  modVersion.Version,
+				GameVersion: modVersion.GameVersion,
+			}
+			for _, dependency := range modVersion.Dependencies {
+				if dependency.ModID == "SML" {
+					row.SMLVersion = dependency.Condition
+				}
+			}
+			rows = append(rows, row)
+		}
+		// Newest first, versions that are not valid semver go last
+		slices.SortStableFunc(rows, func(a, b CompatibilityRow) int {
+			aVersion, aErr := semver.NewVersion(a.ModVersion)
+			bVersion, bErr := semver.NewVersion(b.ModVersion)
+			switch {
+			case aErr != nil && bErr != nil:
+				return 0
+			case aErr != nil:
+				return 1
+			case bErr != nil:
+				return -1
+			}
+			return bVersion.Compare(aVersion)
+		})
+		return rows, nil
+	})
+}
+
+// GetModVersionCompatibilityTable lists the game and SML versions each version of the mod requires,
+// and whether it is compatible with the selected installation and its SML version
+func (a *app) GetModVersionCompatibilityTable(modID string) (CompatibilityTable, error) {
+	if modID == "" {
+		return CompatibilityTable{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	cachedRows, err := getModVersionConstraints(modID)
+	if err != nil {
+		return CompatibilityTable{}, err
+	}
+
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	rows := slices.Clone(cachedRows)
+	for i := range rows {
+		// Requirements that cannot be checked do not make a version incompatible
+		rows[i].IsCompatible = true
+		notes := make([]string, 0, 2)
+		if meta.Info != nil {
+			if factor, ok := gameVersionFactor(rows[i].GameVersion, meta.Info.Version); ok && factor.Points < factor.MaxPoints {
+				rows[i].IsCompatible = false
+				notes = append(notes, factor.Reason)
+			}
+		}
+		if factor, ok := a.smlVersionFactor(rows[i].SMLVersion); ok && factor.Points < factor.MaxPoints {
+			rows[i].IsCompatible = false
+			notes = append(notes, factor.Reason)
+		}
+		rows[i].Notes = strings.Join(notes, "\n")
+	}
+	return CompatibilityTable{Rows: rows}, nil
+}
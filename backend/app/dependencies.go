@@ -0,0 +1,284 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	resolver "github.com/satisfactorymodding/ficsit-resolver"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+// installedModDependencies returns the direct dependencies of each installed mod, keyed by mod reference.
+// The resolver does not store dependencies in the lockfile, so they come from the installed versions.
+// Mods whose version data cannot be fetched are left out.
+func installedModDependencies(lockfileMods map[string]resolver.LockedMod) map[string]map[string]ModVersionConstraint {
+	dependencies := make(map[string]map[string]ModVersionConstraint, len(lockfileMods))
+	for modReference, lockedMod := range lockfileMods {
+		modDependencies, err := modVersionDependencies(modReference, lockedMod.Version)
+		if err != nil {
+			slog.Warn("failed to get mod dependencies", slog.String("mod", modReference), slog.String("version", lockedMod.Version), slog.Any("error", err))
+			continue
+		}
+		dependencies[modReference] = modDependencies
+	}
+	return dependencies
+}
+
+// GetModDependents returns the installed mods that require the given mod. Optional dependencies are not included.
+func (a *app) GetModDependents(modID string) ([]string, error) {
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	dependents := make([]string, 0)
+	for modReference, modDependencies := range installedModDependencies(lockfileMods) {
+		if dependency, ok := modDependencies[modID]; ok && !dependency.Optional {
+			dependents = append(dependents, modReference)
+		}
+	}
+	slices.Sort(dependents)
+	return dependents, nil
+}
+
+type GraphNode struct {
+	ModID   string `json:"modId"`
+	Version string `json:"version"`
+	// IsMissing is set for dependencies that are not installed
+	IsMissing bool `json:"isMissing"`
+}
+
+type GraphEdge struct {
+	From              string `json:"from"`
+	To                string `json:"to"`
+	VersionConstraint string `json:"versionConstraint"`
+	Optional          bool   `json:"optional"`
+}
+
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GetInstalledModDependencyGraph returns every installed mod, and what each depends on, sorted by mod ID.
+// Optional dependencies are only included when they are installed.
+func (a *app) GetInstalledModDependencyGraph() (DependencyGraph, error) {
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return DependencyGraph{}, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+
+	graph := DependencyGraph{
+		Nodes: make([]GraphNode, 0, len(lockfileMods)),
+		Edges: make([]GraphEdge, 0),
+	}
+	missing := make(map[string]bool)
+	dependencies := installedModDependencies(lockfileMods)
+	for modID, lockedMod := range lockfileMods {
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ModID:   modID,
+			Version: lockedMod.Version,
+		})
+		for dependencyID, dependency := range dependencies[modID] {
+			_, installed := lockfileMods[dependencyID]
+			if !installed {
+				if dependency.Optional {
+					continue
+				}
+				missing[dependencyID] = true
+			}
+			graph.Edges = append(graph.Edges, GraphEdge{
+				From:              modID,
+				To:                dependencyID,
+				VersionConstraint: dependency.Constraint,
+				Optional:          dependency.Optional,
+			})
+		}
+	}
+	for modID := range missing {
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ModID:     modID,
+			IsMissing: true,
+		})
+	}
+
+	slices.SortFunc(graph.Nodes, func(a, b GraphNode) int {
+		return strings.Compare(a.ModID, b.ModID)
+	})
+	slices.SortFunc(graph.Edges, func(a, b GraphEdge) int {
+		if c := strings.Compare(a.From, b.From); c != 0 {
+			return c
+		}
+		return strings.Compare(a.To, b.To)
+	})
+	return graph, nil
+}
+
+var ErrNotADependency = fmt.Errorf("mod does not depend on this mod")
+
+// Keyed by mod reference, the direct dependencies of the mod's latest version and their constraints
+var latestModDependenciesCache = utils.NewTTLCache[string, map[string]string](1 * time.Hour)
+
+// latestModVersion returns the highest version of the mod, as seen by the resolver
+func latestModVersion(modID string) (resolver.ModVersion, error) {
+	modVersions, err := ficsitcli.FicsitCLI.GetModVersions(modID)
+	if err != nil {
+		return resolver.ModVersion{}, err
+	}
+	var latest resolver.ModVersion
+	var latestVersion *semver.Version
+	for _, modVersion := range modVersions {
+		version, err := semver.NewVersion(modVersion.Version)
+		if err != nil {
+			continue
+		}
+		if latestVersion == nil || version.GreaterThan(latestVersion) {
+			latest, latestVersion = modVersion, version
+		}
+	}
+	if latestVersion == nil {
+		return resolver.ModVersion{}, fmt.Errorf("mod %s has no versions", modID)
+	}
+	return latest, nil
+}
+
+// GetModDependencyVersion returns the version constraint a mod has on one of its direct dependencies.
+// Installed mods use the constraint of their installed version, other mods use their latest version.
+func (a *app) GetModDependencyVersion(modID, dependencyID string) (string, error) {
+	if modID == "" || dependencyID == "" {
+		return "", fmt.Errorf("mod ID cannot be empty")
+	}
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return "", fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	if lockedMod, ok := lockfileMods[modID]; ok {
+		dependencies, err := modVersionDependencies(modID, lockedMod.Version)
+		if err != nil {
+			return "", err
+		}
+		dependency, ok := dependencies[dependencyID]
+		if !ok {
+			return "", fmt.Errorf("%w: %s does not depend on %s", ErrNotADependency, modID, dependencyID)
+		}
+		return dependency.Constraint, nil
+	}
+
+	dependencies, err := latestModDependenciesCache.GetOrCompute(modID, func() (map[string]string, error) {
+		modVersion, err := latestModVersion(modID)
+		if err != nil {
+			return nil, err
+		}
+		dependencies := make(map[string]string, len(modVersion.Dependencies))
+		for _, dependency := range modVersion.Dependencies {
+			dependencies[dependency.ModID] = dependency.Condition
+		}
+		return dependencies, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if constraint, ok := dependencies[dependencyID]; ok {
+		return constraint, nil
+	}
+	return "", fmt.Errorf("%w: %s does not depend on %s", ErrNotADependency, modID, dependencyID)
+}
+
+type ModVersionConstraint struct {
+	ModID      string `json:"modId"`
+	Constraint string `json:"constraint"`
+	Optional   bool   `json:"optional"`
+}
+
+type DependencyVersionChange struct {
+	ModID         string `json:"modId"`
+	OldConstraint string `json:"oldConstraint"`
+	NewConstraint string `json:"newConstraint"`
+	OldOptional   bool   `json:"oldOptional"`
+	NewOptional   bool   `json:"newOptional"`
+}
+
+// DependencyDiff lists how the direct dependencies changed between two versions of a mod, sorted by mod ID
+type DependencyDiff struct {
+	Added   []ModVersionConstraint    `json:"added"`
+	Removed []ModVersionConstraint    `json:"removed"`
+	Updated []DependencyVersionChange `json:"updated"`
+}
+
+// Published versions do not change their dependencies, so they are never evicted
+var modVersionDependenciesCache = utils.NewTTLCache[modVersionCacheKey, map[string]ModVersionConstraint](0)
+
+// modVersionDependencies returns the direct dependencies of a mod version, keyed by mod reference. The map must not be modified.
+func modVersionDependencies(modID, version string) (map[string]ModVersionConstraint, error) {
+	return modVersionDependenciesCache.GetOrCompute(modVersionCacheKey{modID, version}, func() (map[string]ModVersionConstraint, error) {
+		modVersion, err := ficsitcli.FicsitCLI.GetModVersion(modID, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mod version: %w", err)
+		}
+		dependencies := make(map[string]ModVersionConstraint, len(modVersion.Dependencies))
+		for _, dependency := range modVersion.Dependencies {
+			dependencies[dependency.ModID] = ModVersionConstraint{
+				ModID:      dependency.ModID,
+				Constraint: dependency.Condition,
+				Optional:   dependency.Optional,
+			}
+		}
+		return dependencies, nil
+	})
+}
+
+// GetModDependencyChanges compares the direct dependencies of two versions of a mod, for the update prompt
+func (a *app) GetModDependencyChanges(modID, oldVersion, newVersion string) (DependencyDiff, error) {
+	if modID == "" {
+		return DependencyDiff{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	oldDependencies, err := modVersionDependencies(modID, oldVersion)
+	if err != nil {
+		return DependencyDiff{}, err
+	}
+	newDependencies, err := modVersionDependencies(modID, newVersion)
+	if err != nil {
+		return DependencyDiff{}, err
+	}
+
+	diff := DependencyDiff{
+		Added:   make([]ModVersionConstraint, 0),
+		Removed: make([]ModVersionConstraint, 0),
+		Updated: make([]DependencyVersionChange, 0),
+	}
+	for dependencyID, oldDependency := range oldDependencies {
+		newDependency, ok := newDependencies[dependencyID]
+		if !ok {
+			diff.Removed = append(diff.Removed, oldDependency)
+			continue
+		}
+		if oldDependency != newDependency {
+			diff.Updated = append(diff.Updated, DependencyVersionChange{
+				ModID:         dependencyID,
+				OldConstraint: oldDependency.Constraint,
+				NewConstraint: newDependency.Constraint,
+				OldOptional:   oldDependency.Optional,
+				NewOptional:   newDependency.Optional,
+			})
+		}
+	}
+	for dependencyID, newDependency := range newDependencies {
+		if _, ok := oldDependencies[dependencyID]; !ok {
+			diff.Added = append(diff.Added, newDependency)
+		}
+	}
+	compareConstraints := func(a, b ModVersionConstraint) int {
+		return strings.Compare(a.ModID, b.ModID)
+	}
+	slices.SortFunc(diff.Added, compareConstraints)
+	slices.SortFunc(diff.Removed, compareConstraints)
+	slices.SortFunc(diff.Updated, func(a, b DependencyVersionChange) int {
+		return strings.Compare(a.ModID, b.ModID)
+	})
+	return diff, nil
+}
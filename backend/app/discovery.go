@@ -0,0 +1,190 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const featuredModsCount = 10
+
+var featuredModsCache = utils.NewTTLCache[string, []ModSummary](6 * time.Hour)
+
+// fetchFeaturedModReferences fetches the manually maintained list of featured mod references
+func fetchFeaturedModReferences() ([]string, error) {
+	response, err := http.Get(viper.GetString("featured-mods-url"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get featured mods: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get featured mods: %s", response.Status)
+	}
+	var modReferences []string
+	err = json.NewDecoder(response.Body).Decode(&modReferences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode featured mods: %w", err)
+	}
+	return modReferences, nil
+}
+
+func getFeaturedMods() ([]ModSummary, error) {
+	modReferences, err := fetchFeaturedModReferences()
+	if err != nil {
+		slog.Warn("failed to get featured mods, falling back to trending mods", slog.Any("error", err))
+	}
+	if len(modReferences) > 0 {
+		modsData, err := getModsData(modReferences)
+		if err != nil {
+			return nil, err
+		}
+		featured := make([]ModSummary, 0, featuredModsCount)
+		for _, modReference := range modReferences {
+			mod, ok := modsData[modReference]
+			if !ok {
+				continue
+			}
+			featured = append(featured, toModSummary(mod))
+			if len(featured) == featuredModsCount {
+				break
+			}
+		}
+		return featured, nil
+	}
+
+	// No mods are featured while the list is empty.
+	// ficsit.app's hotness is based on recent downloads, so it is the closest to "most downloaded this week"
+	mods, _, err := queryMods(map[string]interface{}{
+		"order_by": "hotness",
+		"order":    "desc",
+		"limit":    featuredModsCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toModSummaries(mods), nil
+}
+
+func (a *app) GetFeaturedMods() ([]ModSummary, error) {
+	featured, err := featuredModsCache.GetOrCompute("", getFeaturedMods)
+	if err != nil {
+		return nil, err
+	}
+	return withUserState(featured, false), nil
+}
+
+const (
+	defaultPopularModsCount = 10
+	maxPopularModsCount     = 50
+)
+
+// The top mods by hotness, before excluding the installed ones
+var popularModsCache = utils.NewTTLCache[string, []ModSummary](6 * time.Hour)
+
+// GetPopularModsThisWeek returns the n hottest mods that are not in the selected profile.
+// ficsit.app has no weekly download counts, hotness is based on recent downloads instead.
+func (a *app) GetPopularModsThisWeek(n int) ([]ModSummary, error) {
+	if n <= 0 {
+		n = defaultPopularModsCount
+	}
+	n = min(n, maxPopularModsCount)
+
+	popular, err := popularModsCache.GetOrCompute("", func() ([]ModSummary, error) {
+		mods, _, err := queryMods(map[string]interface{}{
+			"order_by": "hotness",
+			"order":    "desc",
+			"limit":    ficsitAPIMaxLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return toModSummaries(mods), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profileMods := ficsitcli.FicsitCLI.GetSelectedInstallProfileMods()
+	popular = slices.DeleteFunc(withUserState(popular, false), func(mod ModSummary) bool {
+		_, ok := profileMods[mod.ModID]
+		return ok
+	})
+	if len(popular) > n {
+		popular = popular[:n]
+	}
+	return popular, nil
+}
+
+const (
+	defaultNewlyPublishedCount = 10
+	maxNewlyPublishedCount     = ficsitAPIMaxLimit
+)
+
+type newlyPublishedCacheKey struct {
+	since int64
+	n     int
+}
+
+var newlyPublishedModsCache = utils.NewTTLCache[newlyPublishedCacheKey, []ModSummary](1 * time.Hour)
+
+func getNewlyPublishedMods(since time.Time, n int) ([]ModSummary, error) {
+	newMods := make([]ficsitMod, 0, n)
+	for offset := 0; len(newMods) < n; offset += ficsitAPIMaxLimit {
+		mods, _, err := queryMods(map[string]interface{}{
+			"order_by": "created_at",
+			"order":    "desc",
+			"limit":    ficsitAPIMaxLimit,
+			"offset":   offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range mods {
+			// The mods are sorted newest first, so the rest are older too
+			if !mod.CreatedAt.After(since) {
+				return toModSummaries(newMods), nil
+			}
+			newMods = append(newMods, mod)
+			if len(newMods) == n {
+				break
+			}
+		}
+		if len(mods) < ficsitAPIMaxLimit {
+			break
+		}
+	}
+	return toModSummaries(newMods), nil
+}
+
+// GetNewlyPublishedMods returns up to n mods created after since, newest first.
+// A zero since means the last time this was called, which is saved as LastModBrowseTime.
+func (a *app) GetNewlyPublishedMods(since time.Time, n int) ([]ModSummary, error) {
+	if n <= 0 {
+		n = defaultNewlyPublishedCount
+	}
+	n = min(n, maxNewlyPublishedCount)
+	if since.IsZero() {
+		since = settings.Settings.LastModBrowseTime
+	}
+
+	newMods, err := newlyPublishedModsCache.GetOrCompute(newlyPublishedCacheKey{since.Unix(), n}, func() ([]ModSummary, error) {
+		return getNewlyPublishedMods(since, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Settings.LastModBrowseTime = time.Now()
+	_ = settings.SaveSettings()
+
+	return withUserState(newMods, false), nil
+}
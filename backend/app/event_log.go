@@ -0,0 +1,8 @@
+package app
+
+import "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+
+// GetEventLog returns the last 200 events emitted to the frontend during this session, oldest first
+func (a *app) GetEventLog() ([]common.EventLogEntry, error) {
+	return common.GetEventLog(), nil
+}
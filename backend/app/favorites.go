@@ -0,0 +1,42 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+func isFavorite(modID string) bool {
+	return slices.Contains(settings.Settings.FavoriteMods, modID)
+}
+
+func (a *app) FavoriteMod(modID string) error {
+	if modID == "" {
+		return fmt.Errorf("mod ID cannot be empty")
+	}
+	added, err := settings.Settings.FavoriteMod(modID)
+	if err != nil {
+		return fmt.Errorf("failed to save favorite: %w", err)
+	}
+	if added {
+		common.EmitEvent(common.AppContext, "favoriteAdded", modID)
+	}
+	return nil
+}
+
+func (a *app) UnfavoriteMod(modID string) error {
+	if modID == "" {
+		return fmt.Errorf("mod ID cannot be empty")
+	}
+	if settings.Settings.UnFavoriteMod(modID) {
+		common.EmitEvent(common.AppContext, "favoriteRemoved", modID)
+	}
+	return nil
+}
+
+// GetFavorites returns the favorite mod IDs in the order they were added
+func (a *app) GetFavorites() ([]string, error) {
+	return slices.Clone(settings.Settings.GetFavoriteMods()), nil
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+// Keyed by URL, like the news feed
+var featureFlagsCache = utils.NewTTLCache[string, map[string]bool](1 * time.Hour)
+
+var (
+	lastFeatureFlags   map[string]bool
+	lastFeatureFlagsMu sync.Mutex
+)
+
+func fetchFeatureFlags() map[string]bool {
+	if settings.Settings.Offline {
+		return map[string]bool{}
+	}
+	flagsURL := viper.GetString("feature-flags-url")
+	flags, err := featureFlagsCache.GetOrCompute(flagsURL, func() (map[string]bool, error) {
+		var flags map[string]bool
+		err := getJSON(flagsURL, &flags)
+		if err != nil {
+			return nil, err
+		}
+		return flags, nil
+	})
+
+	lastFeatureFlagsMu.Lock()
+	defer lastFeatureFlagsMu.Unlock()
+	if err != nil {
+		// Experimental features stay off until the flags could be fetched once
+		slog.Warn("failed to fetch feature flags, using the last fetched flags", slog.Any("error", err))
+		if lastFeatureFlags == nil {
+			return map[string]bool{}
+		}
+		return maps.Clone(lastFeatureFlags)
+	}
+	lastFeatureFlags = flags
+	return maps.Clone(flags)
+}
+
+// prefetchFeatureFlags fills the cache on startup, so the frontend does not wait on the request
+func prefetchFeatureFlags() {
+	_ = fetchFeatureFlags()
+}
+
+// GetFeatureFlags returns the remote feature flags, with the local overrides from the settings applied on top
+func (a *app) GetFeatureFlags() (map[string]bool, error) {
+	flags := fetchFeatureFlags()
+	for flag, enabled := range settings.Settings.FeatureFlagOverrides {
+		flags[flag] = enabled
+	}
+	return flags, nil
+}
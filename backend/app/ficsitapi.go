@@ -0,0 +1,177 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+var ErrOffline = fmt.Errorf("ficsit.app is not available in offline mode")
+
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func queryFicsitAPI(query string, variables map[string]interface{}, result interface{}) error {
+	if ficsitcli.FicsitCLI.GetOffline() {
+		return ErrOffline
+	}
+
+	body, err := json.Marshal(graphqlRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	response, err := http.Post(App.GetAPIEndpoint(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to query ficsit.app: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("ficsit.app returned status %s", response.Status)
+	}
+
+	var graphqlResp graphqlResponse
+	err = json.NewDecoder(response.Body).Decode(&graphqlResp)
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(graphqlResp.Errors) > 0 {
+		return fmt.Errorf("ficsit.app returned an error: %s", graphqlResp.Errors[0].Message)
+	}
+
+	err = json.Unmarshal(graphqlResp.Data, result)
+	if err != nil {
+		return fmt.Errorf("failed to decode response data: %w", err)
+	}
+	return nil
+}
+
+type ficsitTag struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ficsitAuthor struct {
+	Role string `json:"role"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+type ficsitMod struct {
+	ID               string         `json:"id"`
+	ModReference     string         `json:"mod_reference"`
+	Name             string         `json:"name"`
+	ShortDescription string         `json:"short_description"`
+	Logo             string         `json:"logo"`
+	Downloads        int64          `json:"downloads"`
+	Views            int64          `json:"views"`
+	CreatedAt        time.Time      `json:"created_at"`
+	LastVersionDate  *time.Time     `json:"last_version_date"`
+	Tags             []ficsitTag    `json:"tags"`
+	Authors          []ficsitAuthor `json:"authors"`
+}
+
+const ficsitModFields = `
+id
+mod_reference
+name
+short_description
+logo
+downloads
+views
+created_at
+last_version_date
+tags {
+  id
+  name
+}
+authors {
+  role
+  user {
+    id
+    username
+  }
+}
+`
+
+const getModsDataQuery = `query GetModsData($filter: ModFilter) {
+  getMods(filter: $filter) {
+    count
+    mods {` + ficsitModFields + `}
+  }
+}`
+
+// queryMods runs a getMods query with the given ModFilter, returning the mods and the total count of matching mods
+func queryMods(filter map[string]interface{}) ([]ficsitMod, int, error) {
+	var response struct {
+		GetMods struct {
+			Count int         `json:"count"`
+			Mods  []ficsitMod `json:"mods"`
+		} `json:"getMods"`
+	}
+	err := queryFicsitAPI(getModsDataQuery, map[string]interface{}{
+		"filter": filter,
+	}, &response)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, mod := range response.GetMods.Mods {
+		modDataCache.Set(mod.ModReference, mod)
+	}
+	return response.GetMods.Mods, response.GetMods.Count, nil
+}
+
+// ficsit.app does not allow requesting more mods than this in a single query
+const ficsitAPIMaxLimit = 100
+
+var modDataCache = utils.NewTTLCache[string, ficsitMod](1 * time.Hour)
+
+// getModsData returns the ficsit.app data of the requested mods, keyed by mod reference.
+// Mods that are not found on ficsit.app are missing from the result.
+func getModsData(modReferences []string) (map[string]ficsitMod, error) {
+	result := make(map[string]ficsitMod, len(modReferences))
+	missing := make([]string, 0, len(modReferences))
+	for _, modReference := range modReferences {
+		if mod, ok := modDataCache.Get(modReference); ok {
+			result[modReference] = mod
+			continue
+		}
+		missing = append(missing, modReference)
+	}
+
+	for start := 0; start < len(missing); start += ficsitAPIMaxLimit {
+		batch := missing[start:min(start+ficsitAPIMaxLimit, len(missing))]
+		mods, _, err := queryMods(map[string]interface{}{
+			"references": batch,
+			"limit":      len(batch),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range mods {
+			result[mod.ModReference] = mod
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/browser"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+)
+
+var (
+	ErrGameDirectoryNotFound = fmt.Errorf("game directory not found, select a different installation")
+	ErrSMLNotInstalled       = fmt.Errorf("SML is not installed")
+)
+
+func (a *app) OpenGameDirectory() error {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return ErrGameDirectoryNotFound
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info != nil && meta.Info.Location != common.LocationTypeLocal {
+		return fmt.Errorf("cannot open the directory of a remote installation")
+	}
+
+	stat, err := os.Stat(selectedInstall.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: %s", ErrGameDirectoryNotFound, selectedInstall.Path)
+		}
+		return fmt.Errorf("failed to stat game directory: %w", err)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("%w: %s is not a directory", ErrGameDirectoryNotFound, selectedInstall.Path)
+	}
+
+	err = browser.OpenFile(selectedInstall.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open game directory: %w", err)
+	}
+	return nil
+}
+
+// GetSMLInstallPath returns the directory SML is installed to in the selected local installation
+func (a *app) GetSMLInstallPath() (string, error) {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return "", ErrGameDirectoryNotFound
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info != nil && meta.Info.Location != common.LocationTypeLocal {
+		return "", fmt.Errorf("cannot get the SML directory of a remote installation")
+	}
+
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return "", fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	if _, ok := lockfileMods["SML"]; !ok {
+		return "", ErrSMLNotInstalled
+	}
+
+	smlPath := filepath.Join(selectedInstall.Path, "FactoryGame", "Mods", "SML")
+	stat, err := os.Stat(smlPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrSMLNotInstalled
+		}
+		return "", fmt.Errorf("failed to stat SML directory: %w", err)
+	}
+	if !stat.IsDir() {
+		return "", ErrSMLNotInstalled
+	}
+	return smlPath, nil
+}
+
+type PlatformInfo struct {
+	// Platform is the store of the installation, or Manual for installations not found through a store's launcher
+	Platform      string `json:"platform"`
+	LaunchCommand string `json:"launchCommand"`
+	StoreID       string `json:"storeId"`
+	// IsVerified is whether the installation was found through its store and its game files could be read
+	IsVerified bool `json:"isVerified"`
+}
+
+const platformManual = "Manual"
+
+// GetPlatformInfo returns where the installation comes from, and the command LaunchGame uses for it.
+// An empty path means the selected installation.
+func (a *app) GetPlatformInfo(installPath string) (PlatformInfo, error) {
+	if installPath == "" {
+		selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+		if selectedInstall == nil {
+			return PlatformInfo{}, ErrGameDirectoryNotFound
+		}
+		installPath = selectedInstall.Path
+	}
+	meta, ok := ficsitcli.FicsitCLI.GetInstallationsMetadata()[installPath]
+	if !ok {
+		return PlatformInfo{}, fmt.Errorf("installation %s not found", installPath)
+	}
+	if meta.Info == nil {
+		return PlatformInfo{Platform: platformManual}, nil
+	}
+
+	info := PlatformInfo{
+		Platform:      string(meta.Info.Store),
+		LaunchCommand: strings.Join(meta.Info.LaunchPath, " "),
+		StoreID:       meta.Info.StoreID,
+		IsVerified:    meta.Info.Store != "" && meta.State == ficsitcli.InstallStateValid,
+	}
+	if info.Platform == "" {
+		info.Platform = platformManual
+	}
+	return info, nil
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const getModsGameVersionsQuery = `query GetModsGameVersions($filter: ModFilter) {
+  getMods(filter: $filter) {
+    mods {
+      mod_reference
+      versions(filter: { limit: 100, order_by: created_at, order: desc }) {
+        game_version
+      }
+    }
+  }
+}`
+
+// Keyed by mod reference, the game version constraints of the mod's latest versions
+var modGameVersionsCache = utils.NewTTLCache[string, []string](30 * time.Minute)
+
+func getModsGameVersions(modReferences []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(modReferences))
+	missing := make([]string, 0, len(modReferences))
+	for _, modReference := range modReferences {
+		if gameVersions, ok := modGameVersionsCache.Get(modReference); ok {
+			result[modReference] = gameVersions
+			continue
+		}
+		missing = append(missing, modReference)
+	}
+
+	for start := 0; start < len(missing); start += ficsitAPIMaxLimit {
+		batch := missing[start:min(start+ficsitAPIMaxLimit, len(missing))]
+		var response struct {
+			GetMods struct {
+				Mods []struct {
+					ModReference string `json:"mod_reference"`
+					Versions     []struct {
+						GameVersion string `json:"game_version"`
+					} `json:"versions"`
+				} `json:"mods"`
+			} `json:"getMods"`
+		}
+		err := queryFicsitAPI(getModsGameVersionsQuery, map[string]interface{}{
+			"filter": map[string]interface{}{
+				"references": batch,
+				"limit":      len(batch),
+			},
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range response.GetMods.Mods {
+			gameVersions := make([]string, 0, len(mod.Versions))
+			for _, version := range mod.Versions {
+				gameVersions = append(gameVersions, version.GameVersion)
+			}
+			modGameVersionsCache.Set(mod.ModReference, gameVersions)
+			result[mod.ModReference] = gameVersions
+		}
+	}
+	return result, nil
+}
+
+// parseGameVersion parses a game CL the same way ficsit-resolver does
+func parseGameVersion(gameVersion string) (*semver.Version, error) {
+	version, err := semver.NewVersion(gameVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game version %s: %w", gameVersion, err)
+	}
+	return version, nil
+}
+
+// filterModsByGameVersion keeps the mods that have at least one version compatible with the game version
+func filterModsByGameVersion(mods []ficsitMod, gameVersion string) ([]ficsitMod, error) {
+	version, err := parseGameVersion(gameVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	modReferences := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		modReferences = append(modReferences, mod.ModReference)
+	}
+	modsGameVersions, err := getModsGameVersions(modReferences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mod game versions: %w", err)
+	}
+
+	filtered := make([]ficsitMod, 0, len(mods))
+	for _, mod := range mods {
+		for _, gameVersionConstraint := range modsGameVersions[mod.ModReference] {
+			if gameVersionConstraint == "" {
+				continue
+			}
+			constraint, err := semver.NewConstraint(gameVersionConstraint)
+			if err != nil {
+				slog.Warn("failed to parse game version constraint", slog.String("mod", mod.ModReference), slog.String("constraint", gameVersionConstraint), slog.Any("error", err))
+				continue
+			}
+			if constraint.Check(version) {
+				filtered = append(filtered, mod)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
+
+// GetModsByGameVersion returns a page of the most popular mods, keeping only those compatible with the game CL
+func (a *app) GetModsByGameVersion(gameVersion string, page int) ([]ModSummary, error) {
+	if _, err := parseGameVersion(gameVersion); err != nil {
+		return nil, err
+	}
+	result, err := a.SearchMods(SearchQuery{
+		OrderBy:     "popularity",
+		Order:       "desc",
+		Page:        page,
+		GameVersion: gameVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Mods, nil
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	psUtilDisk "github.com/shirou/gopsutil/v3/disk"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type HealthCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail"`
+}
+
+type HealthReport struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []HealthCheck `json:"checks"`
+}
+
+const (
+	healthCheckModFiles         = "Mod files"
+	healthCheckProfile          = "Profile"
+	healthCheckSML              = "SML"
+	healthCheckWritePermissions = "Write permissions"
+	healthCheckDiskSpace        = "Disk space"
+)
+
+// Mods are usually well below this, but updates of large mods need room for both the archive and the extracted files
+const minFreeGameDiskSpace = 1024 * 1024 * 1024
+
+func checkModFiles() HealthCheck {
+	check := HealthCheck{Name: healthCheckModFiles}
+	issues, err := checkLaunchReadiness()
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	check.OK = len(issues) == 0
+	check.Detail = strings.Join(issues, "\n")
+	return check
+}
+
+func checkProfileResolves() HealthCheck {
+	check := HealthCheck{Name: healthCheckProfile}
+	_, err := ficsitcli.FicsitCLI.ResolveSelectedProfileWith(nil)
+	if err != nil {
+		check.Detail = fmt.Sprintf("The selected profile cannot be resolved: %s", err.Error())
+		return check
+	}
+	check.OK = true
+	return check
+}
+
+func (a *app) checkSML() HealthCheck {
+	check := HealthCheck{Name: healthCheckSML}
+	smlPath, err := a.GetSMLInstallPath()
+	if err != nil {
+		if errors.Is(err, ErrSMLNotInstalled) {
+			lockfileMods, lockfileErr := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+			if lockfileErr == nil && len(lockfileMods) == 0 {
+				// Without any mods, SML is not needed
+				check.OK = true
+				check.Detail = "No mods are installed"
+				return check
+			}
+		}
+		check.Detail = err.Error()
+		return check
+	}
+	check.OK = true
+	check.Detail = smlPath
+	return check
+}
+
+func checkGameDirectoryWritable(installPath string) HealthCheck {
+	check := HealthCheck{Name: healthCheckWritePermissions}
+	modsDir := filepath.Join(installPath, "FactoryGame", "Mods")
+	err := utils.EnsureDirExists(modsDir)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	file, err := os.CreateTemp(modsDir, ".smm-write-test-*")
+	if err != nil {
+		check.Detail = fmt.Sprintf("Cannot write to %s: %s", modsDir, err.Error())
+		return check
+	}
+	_ = file.Close()
+	_ = os.Remove(file.Name())
+	check.OK = true
+	return check
+}
+
+func checkGameDiskSpace(installPath string) HealthCheck {
+	check := HealthCheck{Name: healthCheckDiskSpace}
+	usage, err := psUtilDisk.Usage(installPath)
+	if err != nil {
+		check.Detail = fmt.Sprintf("failed to get disk free space: %s", err.Error())
+		return check
+	}
+	check.OK = usage.Free >= minFreeGameDiskSpace
+	check.Detail = fmt.Sprintf("%d MB free", usage.Free/1024/1024)
+	return check
+}
+
+// CheckInstallHealth runs every check of the selected installation in parallel.
+// The write permission and disk space checks only apply to local installations.
+func (a *app) CheckInstallHealth() (HealthReport, error) {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return HealthReport{}, ErrGameDirectoryNotFound
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+
+	checkFuncs := []func() HealthCheck{
+		checkModFiles,
+		checkProfileResolves,
+		a.checkSML,
+	}
+	if meta.Info != nil && meta.Info.Location == common.LocationTypeLocal {
+		checkFuncs = append(checkFuncs,
+			func() HealthCheck { return checkGameDirectoryWritable(selectedInstall.Path) },
+			func() HealthCheck { return checkGameDiskSpace(selectedInstall.Path) },
+		)
+	}
+
+	// Each check writes to its own index, so the order is stable
+	checks := make([]HealthCheck, len(checkFuncs))
+	var wg sync.WaitGroup
+	for i, checkFunc := range checkFuncs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checks[i] = checkFunc()
+		}()
+	}
+	wg.Wait()
+
+	report := HealthReport{
+		Healthy: true,
+		Checks:  checks,
+	}
+	for _, check := range checks {
+		if !check.OK {
+			report.Healthy = false
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+func (a *app) GetHiddenMods() ([]string, error) {
+	return slices.Clone(settings.Settings.HiddenMods), nil
+}
+
+func (a *app) HideMod(modID string) error {
+	if modID == "" {
+		return fmt.Errorf("mod ID cannot be empty")
+	}
+	if err := settings.Settings.AddHiddenMod(modID); err != nil {
+		return fmt.Errorf("failed to hide mod: %w", err)
+	}
+	return nil
+}
+
+func (a *app) UnhideMod(modID string) error {
+	if modID == "" {
+		return fmt.Errorf("mod ID cannot be empty")
+	}
+	if err := settings.Settings.RemoveHiddenMod(modID); err != nil {
+		return fmt.Errorf("failed to unhide mod: %w", err)
+	}
+	return nil
+}
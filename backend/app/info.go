@@ -1,6 +1,8 @@
 package app
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -22,6 +24,23 @@ func (a *app) GetAPIEndpoint() string {
 	return viper.GetString("api-base") + viper.GetString("graphql-api")
 }
 
+// GetGraphQLPlayground returns the ficsit.app GraphQL endpoint SMM uses, for opening with OpenExternal
+func (a *app) GetGraphQLPlayground() string {
+	return a.GetAPIEndpoint()
+}
+
+var apiVersionRegex = regexp.MustCompile(`^/(v\d+)/`)
+
+// GetAPIVersion returns the version of the ficsit.app API SMM uses.
+// The ficsit.app schema has no version field, so this is the version in the configured GraphQL path.
+func (a *app) GetAPIVersion() (string, error) {
+	match := apiVersionRegex.FindStringSubmatch(viper.GetString("graphql-api"))
+	if match == nil {
+		return "", fmt.Errorf("the GraphQL path %s has no API version", viper.GetString("graphql-api"))
+	}
+	return match[1], nil
+}
+
 func (a *app) GetSiteEndpoint() string {
 	return strings.Replace(viper.GetString("api-base"), "api.", "", 1)
 }
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type InstallProgress struct {
+	// Status is queued until the mod's archive starts downloading, then downloading, then extracting
+	Status          string    `json:"status"`
+	BytesDownloaded int64     `json:"bytesDownloaded"`
+	TotalBytes      int64     `json:"totalBytes"`
+	StepName        string    `json:"stepName"`
+	StartedAt       time.Time `json:"startedAt"`
+}
+
+// GetModInstallProgress returns the progress of the running operation for the mod, or nil if the mod is not part of one.
+// This is the same progress the progress event sends, for recovering the UI state after the frontend reloads.
+func (a *app) GetModInstallProgress(modID string) (*InstallProgress, error) {
+	modProgress, ok := ficsitcli.FicsitCLI.GetModProgress(modID)
+	if !ok {
+		return nil, nil
+	}
+	progress := &InstallProgress{
+		Status:    "queued",
+		StepName:  string(modProgress.Action),
+		StartedAt: modProgress.StartedAt,
+	}
+	if download, ok := modProgress.Steps["download"]; ok {
+		progress.Status = "downloading"
+		progress.StepName = "download"
+		progress.BytesDownloaded = download.Current
+		progress.TotalBytes = download.Total
+	}
+	if _, ok := modProgress.Steps["extract"]; ok {
+		progress.Status = "extracting"
+		progress.StepName = "extract"
+	}
+	return progress, nil
+}
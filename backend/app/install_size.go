@@ -0,0 +1,66 @@
+package app
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type InstallSizeInfo struct {
+	DownloadBytes int64 `json:"downloadBytes"`
+	// ExtractedBytes is exact when the archive is cached, and estimated from the download size otherwise
+	ExtractedBytes      int64 `json:"extractedBytes"`
+	CachedDownloadBytes int64 `json:"cachedDownloadBytes"`
+}
+
+// Mod archives are zips, which usually extract to about 1.3 times their size
+const estimatedZipExtractionRatio = 1.3
+
+func zipExtractedSize(archivePath string) (int64, int64, error) {
+	stat, err := os.Stat(archivePath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to stat cached archive: %w", err)
+	}
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	var extracted int64
+	for _, file := range archive.File {
+		extracted += int64(file.UncompressedSize64)
+	}
+	return stat.Size(), extracted, nil
+}
+
+// GetModInstallSize returns the download and disk size of a mod version for the selected installation's platform.
+// An empty version means the installed version.
+func (a *app) GetModInstallSize(modID, version string) (InstallSizeInfo, error) {
+	if modID == "" {
+		return InstallSizeInfo{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	archiveInfo, err := ficsitcli.FicsitCLI.GetModArchiveInfo(modID, version)
+	if err != nil {
+		return InstallSizeInfo{}, fmt.Errorf("failed to get archive info: %w", err)
+	}
+	return modInstallSize(archiveInfo)
+}
+
+func modInstallSize(archiveInfo ficsitcli.ModArchiveInfo) (InstallSizeInfo, error) {
+	info := InstallSizeInfo{
+		DownloadBytes:  archiveInfo.Size,
+		ExtractedBytes: int64(float64(archiveInfo.Size) * estimatedZipExtractionRatio),
+	}
+	if archiveInfo.CachedPath != "" {
+		cachedSize, extractedSize, err := zipExtractedSize(archiveInfo.CachedPath)
+		if err != nil {
+			return InstallSizeInfo{}, err
+		}
+		info.CachedDownloadBytes = cachedSize
+		info.ExtractedBytes = extractedSize
+	}
+	return info, nil
+}
@@ -0,0 +1,373 @@
+package app
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	ficsitcache "github.com/satisfactorymodding/ficsit-cli/cli/cache"
+	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type InstalledModInfo struct {
+	ModID      string `json:"modId"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Enabled    bool   `json:"enabled"`
+	IsFavorite bool   `json:"isFavorite"`
+	// InstalledAt is nil for mods installed before install dates were tracked, and for dependencies
+	InstalledAt *time.Time `json:"installedAt"`
+
+	// DiskSize and CumulativeSize are only set by GetInstalledModsBySize
+	DiskSize       int64 `json:"diskSize,omitempty"`
+	CumulativeSize int64 `json:"cumulativeSize,omitempty"`
+}
+
+// getInstalledMods returns the mods of the selected profile, and the dependencies installed for them
+func getInstalledMods() ([]InstalledModInfo, error) {
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	profileMods := ficsitcli.FicsitCLI.GetSelectedInstallProfileMods()
+	installDates := ficsitcli.FicsitCLI.GetSelectedInstallModInstallDates()
+
+	modIDs := make([]string, 0, len(lockfileMods)+len(profileMods))
+	for modID := range profileMods {
+		modIDs = append(modIDs, modID)
+	}
+	for modID := range lockfileMods {
+		if _, ok := profileMods[modID]; !ok {
+			modIDs = append(modIDs, modID)
+		}
+	}
+
+	mods := make([]InstalledModInfo, 0, len(modIDs))
+	for _, modID := range modIDs {
+		info := InstalledModInfo{
+			ModID:      modID,
+			Name:       modID,
+			Enabled:    true,
+			IsFavorite: isFavorite(modID),
+		}
+		if profileMod, ok := profileMods[modID]; ok {
+			info.Enabled = profileMod.Enabled
+		}
+		if lockedMod, ok := lockfileMods[modID]; ok {
+			info.Version = lockedMod.Version
+		}
+		if installedAt, ok := installDates[modID]; ok {
+			info.InstalledAt = &installedAt
+		}
+		// Prefer the name from the cached archive, so this works offline too
+		if cachedMod, err := ficsitcache.GetCacheMod(modID); err == nil && cachedMod.Name != "" {
+			info.Name = cachedMod.Name
+		} else if mod, ok := modDataCache.Get(modID); ok {
+			info.Name = mod.Name
+		}
+		mods = append(mods, info)
+	}
+
+	sortInstalledModsByName(mods)
+	return mods, nil
+}
+
+// installedModVersion returns the locked version of the mod in the selected installation
+func installedModVersion(modID string) (string, error) {
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return "", fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	lockedMod, ok := lockfileMods[modID]
+	if !ok {
+		return "", fmt.Errorf("mod %s is not installed", modID)
+	}
+	return lockedMod.Version, nil
+}
+
+func sortInstalledModsByName(mods []InstalledModInfo) {
+	slices.SortFunc(mods, func(a, b InstalledModInfo) int {
+		if c := strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ModID, b.ModID)
+	})
+}
+
+// modDiskSize is read from the entries of the mod's cached archive, which are the files extracted for it.
+// Only mods without a cached archive have their directory walked, and only on local installations.
+func modDiskSize(mod InstalledModInfo, modsDir string) (int64, error) {
+	archivePath, err := ficsitcli.FicsitCLI.GetCachedModArchivePath(mod.ModID, mod.Version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cached archive: %w", err)
+	}
+	if archivePath != "" {
+		_, extractedSize, err := zipExtractedSize(archivePath)
+		if err == nil {
+			return extractedSize, nil
+		}
+	}
+	if modsDir == "" {
+		return 0, nil
+	}
+	info, err := orphanedFileInfo(filepath.Join(modsDir, mod.ModID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+// GetInstalledModsBySize returns the installed mods, largest first.
+// CumulativeSize is the total size of the mod and all the larger ones before it.
+func (a *app) GetInstalledModsBySize() ([]InstalledModInfo, error) {
+	mods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+	// Remote installations only have their archives to go by
+	modsDir, err := getLocalModsDirectory()
+	if err != nil {
+		modsDir = ""
+	}
+
+	mods = slices.DeleteFunc(mods, func(mod InstalledModInfo) bool {
+		return mod.Version == ""
+	})
+	for i := range mods {
+		mods[i].DiskSize, err = modDiskSize(mods[i], modsDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The mods are already sorted by name, so the stable sort keeps that order for ties
+	slices.SortStableFunc(mods, func(a, b InstalledModInfo) int {
+		return cmp.Compare(b.DiskSize, a.DiskSize)
+	})
+	var total int64
+	for i := range mods {
+		total += mods[i].DiskSize
+		mods[i].CumulativeSize = total
+	}
+	return mods, nil
+}
+
+const defaultRecentlyInstalledCount = 10
+
+// GetMostRecentlyInstalledMods returns the n most recently installed mods, newest first.
+// Mods without a known install date are not included.
+func (a *app) GetMostRecentlyInstalledMods(n int) ([]InstalledModInfo, error) {
+	if n <= 0 {
+		n = defaultRecentlyInstalledCount
+	}
+	mods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+	mods = slices.DeleteFunc(mods, func(mod InstalledModInfo) bool {
+		return mod.InstalledAt == nil
+	})
+	// The mods are already sorted by name, so the stable sort keeps that order for ties
+	slices.SortStableFunc(mods, func(a, b InstalledModInfo) int {
+		return b.InstalledAt.Compare(*a.InstalledAt)
+	})
+	if len(mods) > n {
+		mods = mods[:n]
+	}
+	return mods, nil
+}
+
+// ModCategory is a ficsit.app tag, which is how ficsit.app categorizes mods
+type ModCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+const getTagsQuery = `query GetTags {
+  getTags(filter: { limit: 100 }) {
+    id
+    name
+  }
+}`
+
+var modCategoriesCache = utils.NewTTLCache[string, []ModCategory](6 * time.Hour)
+
+func (a *app) GetModCategories() ([]ModCategory, error) {
+	return modCategoriesCache.GetOrCompute("", func() ([]ModCategory, error) {
+		var response struct {
+			GetTags []ModCategory `json:"getTags"`
+		}
+		err := queryFicsitAPI(getTagsQuery, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+		return response.GetTags, nil
+	})
+}
+
+func (a *app) GetInstalledModsByCategory(categoryID string) ([]InstalledModInfo, error) {
+	return installedModsWithTags(categoryID)
+}
+
+// GetInstalledModsByTag returns the installed mods that have the tag, and the category if it is not empty.
+// ficsit.app only has tags, categories are also tags, so this is the same filter with two tags.
+func (a *app) GetInstalledModsByTag(tagID, categoryID string) ([]InstalledModInfo, error) {
+	if tagID == "" {
+		return nil, fmt.Errorf("tag ID cannot be empty")
+	}
+	return installedModsWithTags(tagID, categoryID)
+}
+
+// installedModsWithTags returns the installed mods that have all the non-empty tags, sorted by name
+func installedModsWithTags(tagIDs ...string) ([]InstalledModInfo, error) {
+	mods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+	tagIDs = slices.DeleteFunc(tagIDs, func(tagID string) bool { return tagID == "" })
+	if len(tagIDs) == 0 {
+		return mods, nil
+	}
+
+	modIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		modIDs = append(modIDs, mod.ModID)
+	}
+	modsData, err := getModsData(modIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mod categories: %w", err)
+	}
+
+	filtered := make([]InstalledModInfo, 0)
+	for _, mod := range mods {
+		modData, ok := modsData[mod.ModID]
+		if !ok {
+			continue
+		}
+		hasAllTags := true
+		for _, tagID := range tagIDs {
+			if !slices.ContainsFunc(modData.Tags, func(tag ficsitTag) bool { return tag.ID == tagID }) {
+				hasAllTags = false
+				break
+			}
+		}
+		if hasAllTags {
+			filtered = append(filtered, mod)
+		}
+	}
+	return filtered, nil
+}
+
+const unknownAuthor = "Unknown"
+
+// modCreator returns the username of the mod's creator, or its first listed author if no creator is listed
+func modCreator(mod ficsitMod) string {
+	for _, author := range mod.Authors {
+		if author.Role == "creator" && author.User.Username != "" {
+			return author.User.Username
+		}
+	}
+	for _, author := range mod.Authors {
+		if author.User.Username != "" {
+			return author.User.Username
+		}
+	}
+	return unknownAuthor
+}
+
+// GetInstalledModGroupedByAuthor groups the installed mods by the username of their creator, sorted by mod name.
+// Mods that are not on ficsit.app are grouped under "Unknown".
+func (a *app) GetInstalledModGroupedByAuthor() (map[string][]InstalledModInfo, error) {
+	mods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+
+	modIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		modIDs = append(modIDs, mod.ModID)
+	}
+	modsData, err := getModsData(modIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mod authors: %w", err)
+	}
+
+	// The mods are already sorted by name, so each group is too
+	grouped := make(map[string][]InstalledModInfo)
+	for _, mod := range mods {
+		author := unknownAuthor
+		if modData, ok := modsData[mod.ModID]; ok {
+			author = modCreator(modData)
+		}
+		grouped[author] = append(grouped[author], mod)
+	}
+	return grouped, nil
+}
+
+var installedModSortOrders = []string{"name", "size", "install-date", "last-updated"}
+
+func (a *app) GetInstalledModSortOrder() string {
+	return settings.Settings.InstalledModSortOrder
+}
+
+func (a *app) SetInstalledModSortOrder(order string) error {
+	if !slices.Contains(installedModSortOrders, order) {
+		return fmt.Errorf("unsupported sort order: %s", order)
+	}
+	if settings.Settings.InstalledModSortOrder == order {
+		return nil
+	}
+	settings.Settings.InstalledModSortOrder = order
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save sort order: %w", err)
+	}
+	common.EmitEvent(common.AppContext, "installedModSortOrderChanged", order)
+	return nil
+}
+
+// GetInstalledModsJSON returns the installed mods and their versions, dependencies included, as compact JSON for pasting in bug reports
+func (a *app) GetInstalledModsJSON() (string, error) {
+	mods, err := getInstalledMods()
+	if err != nil {
+		return "", err
+	}
+	// A map is marshalled with sorted keys, so the same mods always give the same text
+	versions := make(map[string]string, len(mods))
+	for _, mod := range mods {
+		if mod.Version != "" {
+			versions[mod.ModID] = mod.Version
+		}
+	}
+	b, err := json.Marshal(versions)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal installed mods: %w", err)
+	}
+	return string(b), nil
+}
+
+// CopyInstalledModsToClipboard copies the output of GetInstalledModsJSON to the clipboard
+func (a *app) CopyInstalledModsToClipboard() error {
+	text, err := a.GetInstalledModsJSON()
+	if err != nil {
+		return err
+	}
+	err = wailsRuntime.ClipboardSetText(common.AppContext, text)
+	if err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
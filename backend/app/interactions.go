@@ -100,11 +100,11 @@ func (a *app) OpenDirectoryDialog(options OpenDialogOptions) (string, error) {
 }
 
 func (a *app) ExternalInstallMod(modID, version string) {
-	wailsRuntime.EventsEmit(common.AppContext, "externalInstallMod", modID, version)
+	common.EmitEvent(common.AppContext, "externalInstallMod", modID, version)
 }
 
 func (a *app) ExternalImportProfile(path string) {
-	wailsRuntime.EventsEmit(common.AppContext, "externalImportProfile", path)
+	common.EmitEvent(common.AppContext, "externalImportProfile", path)
 }
 
 func (a *app) Show() {
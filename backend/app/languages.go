@@ -0,0 +1,101 @@
+package app
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+var ErrUnsupportedLocale = fmt.Errorf("unsupported locale")
+
+// languages.json is the committed list of languages. languages.generated.json is written by the frontend's translations script
+// when the translations are pulled, with the completion percentages computed from them. It is not tracked, so it is only used if present.
+//
+//go:embed languages*.json
+var languageManifests embed.FS
+
+type languageManifestEntry struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName"`
+	// CompletionPercent is only in the generated manifest
+	CompletionPercent *int `json:"completionPercent"`
+}
+
+type LanguageInfo struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName"`
+	// CompletionPercent is nil if this build was made without pulling the translations
+	CompletionPercent *int `json:"completionPercent"`
+	IsIncomplete      bool `json:"isIncomplete"`
+}
+
+// Languages with less of their strings translated than this are marked as incomplete
+const languageCompletionThreshold = 30
+
+var getLanguageManifest = sync.OnceValues(func() ([]languageManifestEntry, error) {
+	manifest, err := languageManifests.ReadFile("languages.generated.json")
+	if errors.Is(err, fs.ErrNotExist) {
+		manifest, err = languageManifests.ReadFile("languages.json")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read languages manifest: %w", err)
+	}
+	var entries []languageManifestEntry
+	err = json.Unmarshal(manifest, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse languages manifest: %w", err)
+	}
+	return entries, nil
+})
+
+func (a *app) SetLanguage(locale string) error {
+	entries, err := getLanguageManifest()
+	if err != nil {
+		return err
+	}
+	codes := make([]string, 0, len(entries))
+	supported := false
+	for _, entry := range entries {
+		codes = append(codes, entry.Code)
+		if entry.Code == locale {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("%w: %s, supported locales are: %s", ErrUnsupportedLocale, locale, strings.Join(codes, ", "))
+	}
+
+	if settings.Settings.Language == locale {
+		return nil
+	}
+	settings.Settings.SetLanguage(locale)
+	common.EmitEvent(common.AppContext, "languageChanged", locale)
+	return nil
+}
+
+func (a *app) GetSupportedLanguages() ([]LanguageInfo, error) {
+	entries, err := getLanguageManifest()
+	if err != nil {
+		return nil, err
+	}
+	languages := make([]LanguageInfo, 0, len(entries))
+	for _, entry := range entries {
+		languages = append(languages, LanguageInfo{
+			Code:              entry.Code,
+			Name:              entry.Name,
+			NativeName:        entry.NativeName,
+			CompletionPercent: entry.CompletionPercent,
+			IsIncomplete:      entry.CompletionPercent != nil && *entry.CompletionPercent < languageCompletionThreshold,
+		})
+	}
+	return languages, nil
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/satisfactorymodding/ficsit-cli/cli"
+	resolver "github.com/satisfactorymodding/ficsit-resolver"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type LaunchTestResult struct {
+	Ready  bool     `json:"ready"`
+	Issues []string `json:"issues"`
+}
+
+func checkLaunchReadiness() ([]string, error) {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return []string{"No installation is selected"}, nil
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.State != ficsitcli.InstallStateValid {
+		return []string{fmt.Sprintf("The installation at %s is not valid", utils.RedactPath(selectedInstall.Path))}, nil
+	}
+	if !ficsitcli.FicsitCLI.GetModsEnabled() {
+		// The game is launched without mods, so there is nothing else to check
+		return []string{}, nil
+	}
+
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+
+	issues := make([]string, 0)
+	if len(lockfileMods) > 0 {
+		if _, ok := lockfileMods["SML"]; !ok {
+			issues = append(issues, "Mods are installed, but SML is not")
+		}
+	}
+
+	missingPlugin, err := modsMissingPlugin(selectedInstall, lockfileMods)
+	if err != nil {
+		return nil, err
+	}
+	for _, modID := range missingPlugin {
+		issues = append(issues, fmt.Sprintf("%s is missing its plugin file, repair the installation to reinstall it", modID))
+	}
+	return issues, nil
+}
+
+// modsMissingPlugin returns the installed mods whose .uplugin file is missing, sorted by mod reference
+func modsMissingPlugin(selectedInstall *cli.Installation, lockfileMods map[string]resolver.LockedMod) ([]string, error) {
+	d, err := selectedInstall.GetDisk()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk for installation: %w", err)
+	}
+
+	modsDir := filepath.Join(selectedInstall.BasePath(), "FactoryGame", "Mods")
+	modIDs := make([]string, 0, len(lockfileMods))
+	for modID := range lockfileMods {
+		modIDs = append(modIDs, modID)
+	}
+	slices.Sort(modIDs)
+	missing := make([]string, 0)
+	for _, modID := range modIDs {
+		// Every mod, SML included, is loaded through its .uplugin file
+		upluginPath := filepath.Join(modsDir, modID, modID+".uplugin")
+		exists, err := d.Exists(upluginPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s: %w", upluginPath, err)
+		}
+		if !exists {
+			missing = append(missing, modID)
+		}
+	}
+	return missing, nil
+}
+
+// TestGameLaunch checks that the selected installation has every file needed to launch with the selected profile.
+// Launching the game itself as a smoke test is not done, Satisfactory has no headless mode that
+// works through the Steam and Epic launchers.
+func (a *app) TestGameLaunch() (LaunchTestResult, error) {
+	issues, err := checkLaunchReadiness()
+	if err != nil {
+		return LaunchTestResult{}, err
+	}
+	result := LaunchTestResult{
+		Ready:  len(issues) == 0,
+		Issues: issues,
+	}
+	common.EmitEvent(common.AppContext, "launchTestCompleted", result)
+	return result, nil
+}
@@ -0,0 +1,9 @@
+package app
+
+import (
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+func (a *app) GetGameLaunchHistory(limit int) ([]ficsitcli.LaunchRecord, error) {
+	return ficsitcli.ReadLaunchHistory(limit)
+}
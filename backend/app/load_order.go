@@ -0,0 +1,123 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type LoadOrderEntry struct {
+	ModID    string `json:"modId"`
+	Position int    `json:"position"`
+	Reason   string `json:"reason"`
+}
+
+type LoadOrderReport struct {
+	OrderedMods []LoadOrderEntry `json:"orderedMods"`
+	// Text is the report as plain text, for pasting into bug reports
+	Text string `json:"text"`
+}
+
+// GenerateModLoadOrderReport orders the installed mods so that every mod comes after its dependencies, like the game loads them.
+// Mods that do not depend on each other are ordered by ID, so the order is the same every time.
+func (a *app) GenerateModLoadOrderReport() (LoadOrderReport, error) {
+	graph, err := a.GetInstalledModDependencyGraph()
+	if err != nil {
+		return LoadOrderReport{}, err
+	}
+
+	dependencies := make(map[string][]string)
+	dependents := make(map[string][]string)
+	for _, edge := range graph.Edges {
+		dependencies[edge.From] = append(dependencies[edge.From], edge.To)
+		dependents[edge.To] = append(dependents[edge.To], edge.From)
+	}
+	missing := make(map[string]bool)
+	for _, node := range graph.Nodes {
+		if node.IsMissing {
+			missing[node.ModID] = true
+		}
+	}
+
+	// The number of installed dependencies of each mod that are not placed yet
+	remaining := make(map[string]int)
+	ready := make([]string, 0)
+	for _, node := range graph.Nodes {
+		if node.IsMissing {
+			continue
+		}
+		remaining[node.ModID] = 0
+		for _, dependency := range dependencies[node.ModID] {
+			// Missing dependencies never load, so they do not hold the mod back
+			if !missing[dependency] {
+				remaining[node.ModID]++
+			}
+		}
+		if remaining[node.ModID] == 0 {
+			ready = append(ready, node.ModID)
+		}
+	}
+
+	report := LoadOrderReport{OrderedMods: make([]LoadOrderEntry, 0, len(remaining))}
+	addEntry := func(modID string, reason string) {
+		report.OrderedMods = append(report.OrderedMods, LoadOrderEntry{
+			ModID:    modID,
+			Position: len(report.OrderedMods) + 1,
+			Reason:   reason,
+		})
+		delete(remaining, modID)
+	}
+
+	// Kahn's algorithm, always taking the lowest ID that is ready
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		modID := ready[0]
+		ready = ready[1:]
+
+		modDependencies := slices.DeleteFunc(slices.Clone(dependencies[modID]), func(dependency string) bool {
+			return missing[dependency]
+		})
+		reason := "Has no dependencies"
+		if len(modDependencies) > 0 {
+			slices.Sort(modDependencies)
+			reason = fmt.Sprintf("Depends on %s, so it loads after them", strings.Join(modDependencies, ", "))
+		}
+		addEntry(modID, reason)
+
+		for _, dependent := range dependents[modID] {
+			if _, ok := remaining[dependent]; !ok {
+				continue
+			}
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	// Anything left depends on itself through other mods, so there is no order that satisfies it
+	cycle := make([]string, 0, len(remaining))
+	for modID := range remaining {
+		cycle = append(cycle, modID)
+	}
+	slices.Sort(cycle)
+	for _, modID := range cycle {
+		addEntry(modID, "Part of a dependency cycle, its load order is undefined")
+	}
+
+	var text strings.Builder
+	text.WriteString("Mod load order, derived from the dependencies of the installed mods:\n")
+	for _, entry := range report.OrderedMods {
+		fmt.Fprintf(&text, "%d. %s - %s\n", entry.Position, entry.ModID, entry.Reason)
+	}
+	missingIDs := make([]string, 0, len(missing))
+	for modID := range missing {
+		missingIDs = append(missingIDs, modID)
+	}
+	slices.Sort(missingIDs)
+	for _, modID := range missingIDs {
+		fmt.Fprintf(&text, "Missing dependency: %s\n", modID)
+	}
+	report.Text = text.String()
+	return report, nil
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/migration"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+type MigrationReport struct {
+	SettingsChanges []string `json:"settingsChanges"`
+	ProfileChanges  []string `json:"profileChanges"`
+	// CacheCleared lists the caches cleared by the upgrade, no SMM version currently clears any
+	CacheCleared    []string `json:"cacheCleared"`
+	RequiresRestart bool     `json:"requiresRestart"`
+}
+
+func smm2ProfileChanges() []string {
+	changes := make([]string, 0)
+	if !migration.Migration.NeedsSmm2Migration() {
+		return changes
+	}
+	for _, profile := range migration.Migration.GetSmm2Profiles() {
+		changes = append(changes, fmt.Sprintf("SMM2 profile %s is imported", profile))
+	}
+	return changes
+}
+
+// GetMigrationReport describes how the data of the current SMM installation is migrated when upgrading between the versions.
+// The settings are migrated when they are loaded on startup, so the report lists the changes recorded then.
+func (a *app) GetMigrationReport(fromManagerVersion, toManagerVersion string) (MigrationReport, error) {
+	from, err := semver.NewVersion(fromManagerVersion)
+	if err != nil {
+		return MigrationReport{}, fmt.Errorf("invalid version %s: %w", fromManagerVersion, err)
+	}
+	to, err := semver.NewVersion(toManagerVersion)
+	if err != nil {
+		return MigrationReport{}, fmt.Errorf("invalid version %s: %w", toManagerVersion, err)
+	}
+
+	report := MigrationReport{
+		SettingsChanges: []string{},
+		ProfileChanges:  []string{},
+		CacheCleared:    []string{},
+	}
+	if !to.GreaterThan(from) {
+		return report, nil
+	}
+
+	report.SettingsChanges = append(report.SettingsChanges, settings.AppliedMigrations...)
+	report.ProfileChanges = smm2ProfileChanges()
+	return report, nil
+}
+
+// emitMigrationReport sends the migrations done on this startup to the frontend if SMM was upgraded since it last ran
+func emitMigrationReport() {
+	currentVersion := viper.GetString("version")
+	lastRunVersion := settings.Settings.LastRunVersion
+	if lastRunVersion == currentVersion {
+		return
+	}
+
+	upgraded := len(settings.AppliedMigrations) > 0
+	if lastRunVersion != "" {
+		from, fromErr := semver.NewVersion(lastRunVersion)
+		to, toErr := semver.NewVersion(currentVersion)
+		upgraded = upgraded || (fromErr == nil && toErr == nil && to.GreaterThan(from))
+	}
+	if upgraded {
+		common.EmitEvent(common.AppContext, "migrationReportReady", MigrationReport{
+			SettingsChanges: append([]string{}, settings.AppliedMigrations...),
+			ProfileChanges:  smm2ProfileChanges(),
+			CacheCleared:    []string{},
+			// Everything was migrated during startup already
+			RequiresRestart: false,
+		})
+	}
+
+	settings.Settings.LastRunVersion = currentVersion
+	err := settings.SaveSettings()
+	if err != nil {
+		slog.Error("failed to save last run version", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"debug/pe"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type AssemblyInfo struct {
+	FileName string `json:"fileName"`
+	Name     string `json:"name"`
+	// Version is the file version from the version resource, or empty if the DLL has none
+	Version string `json:"version"`
+	SHA256  string `json:"sha256"`
+	// IsManaged is set for .NET assemblies, the others are native code
+	IsManaged bool `json:"isManaged"`
+	// IsSigned is set if the DLL has an Authenticode signature. The signature is not verified.
+	IsSigned bool `json:"isSigned"`
+}
+
+const (
+	peSecurityDirectory = 4
+	peCLRDirectory      = 14
+	// VS_FIXEDFILEINFO starts with this signature, followed by the struct version and the file version
+	fixedFileInfoSignature = 0xFEEF04BD
+)
+
+// Keyed by archive path, like the pak files
+var modAssembliesCache = utils.NewTTLCache[string, []AssemblyInfo](0)
+
+func peDataDirectories(file *pe.File) []pe.DataDirectory {
+	switch header := file.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		return header.DataDirectory[:min(header.NumberOfRvaAndSizes, uint32(len(header.DataDirectory)))]
+	case *pe.OptionalHeader64:
+		return header.DataDirectory[:min(header.NumberOfRvaAndSizes, uint32(len(header.DataDirectory)))]
+	}
+	return nil
+}
+
+// peFileVersion finds the VS_FIXEDFILEINFO of the version resource.
+// debug/pe does not parse resources, so the data is searched for its signature.
+func peFileVersion(data []byte) string {
+	signature := binary.LittleEndian.AppendUint32(nil, fixedFileInfoSignature)
+	start := bytes.Index(data, signature)
+	if start < 0 || start+16 > len(data) {
+		return ""
+	}
+	versionMS := binary.LittleEndian.Uint32(data[start+8:])
+	versionLS := binary.LittleEndian.Uint32(data[start+12:])
+	return fmt.Sprintf("%d.%d.%d.%d", versionMS>>16, versionMS&0xFFFF, versionLS>>16, versionLS&0xFFFF)
+}
+
+func readAssemblyInfo(file *zip.File) (AssemblyInfo, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return AssemblyInfo{}, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	// debug/pe needs random access, and mod DLLs are small enough to read into memory
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return AssemblyInfo{}, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+
+	peFile, err := pe.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return AssemblyInfo{}, fmt.Errorf("failed to parse %s: %w", file.Name, err)
+	}
+	defer peFile.Close()
+
+	hash := sha256.Sum256(data)
+	info := AssemblyInfo{
+		FileName: file.Name,
+		Name:     strings.TrimSuffix(path.Base(file.Name), path.Ext(file.Name)),
+		Version:  peFileVersion(data),
+		SHA256:   hex.EncodeToString(hash[:]),
+	}
+	directories := peDataDirectories(peFile)
+	if len(directories) > peSecurityDirectory {
+		info.IsSigned = directories[peSecurityDirectory].Size > 0
+	}
+	if len(directories) > peCLRDirectory {
+		info.IsManaged = directories[peCLRDirectory].Size > 0
+	}
+	return info, nil
+}
+
+func getModAssemblies(archivePath string) ([]AssemblyInfo, error) {
+	return modAssembliesCache.GetOrCompute(archivePath, func() ([]AssemblyInfo, error) {
+		archive, err := zip.OpenReader(archivePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open mod archive: %w", err)
+		}
+		defer archive.Close()
+
+		assemblies := make([]AssemblyInfo, 0)
+		for _, file := range archive.File {
+			if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".dll") {
+				continue
+			}
+			info, err := readAssemblyInfo(file)
+			if err != nil {
+				slog.Warn("failed to read mod DLL", slog.String("archive", archivePath), slog.String("file", file.Name), slog.Any("error", err))
+				continue
+			}
+			assemblies = append(assemblies, info)
+		}
+		return assemblies, nil
+	})
+}
+
+// GetModCSharpAssemblies returns the DLLs in a mod version's archive, read with debug/pe.
+// Satisfactory mods are mostly native UE modules, so IsManaged tells the .NET assemblies apart.
+// An empty version means the installed version.
+func (a *app) GetModCSharpAssemblies(modID, version string) ([]AssemblyInfo, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	var assemblies []AssemblyInfo
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		assemblies, err = getModAssemblies(archivePath)
+		return err
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return assemblies, nil
+}
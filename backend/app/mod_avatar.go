@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+var ErrNoAvatar = fmt.Errorf("mod has no logo")
+
+// Keyed by mod reference, kept until restart since mod logos rarely change. An empty URL means the mod has no usable logo.
+var modAvatarURLCache = utils.NewTTLCache[string, string](0)
+
+// modAvatarURL returns the mod's logo URL if it is an HTTPS URL, and caches the result
+func modAvatarURL(mod ficsitMod) string {
+	avatarURL := ""
+	if mod.Logo != "" {
+		if _, err := validateHTTPSURL(mod.Logo); err == nil {
+			avatarURL = mod.Logo
+		}
+	}
+	modAvatarURLCache.Set(mod.ModReference, avatarURL)
+	return avatarURL
+}
+
+// GetModAvatarURL returns the ficsit.app CDN URL of the mod's logo, for the mod list thumbnails
+func (a *app) GetModAvatarURL(modID string) (string, error) {
+	avatarURL, ok := modAvatarURLCache.Get(modID)
+	if !ok {
+		modsData, err := getModsData([]string{modID})
+		if err != nil {
+			return "", err
+		}
+		mod, ok := modsData[modID]
+		if !ok {
+			return "", fmt.Errorf("mod %s not found", modID)
+		}
+		avatarURL = modAvatarURL(mod)
+	}
+	if avatarURL == "" {
+		return "", ErrNoAvatar
+	}
+	return avatarURL, nil
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"archive/zip"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type BuildInfo struct {
+	// UnrealEngineVersion is the EngineVersion of the mod's uplugin, empty if it has none
+	UnrealEngineVersion string `json:"unrealEngineVersion"`
+	// TargetGameVersion is the game version constraint of the mod version, or the range supported by the SML versions it allows
+	TargetGameVersion string `json:"targetGameVersion"`
+	// TargetSMLVersion is the SML version constraint of the mod version, empty if it does not depend on SML
+	TargetSMLVersion string `json:"targetSmlVersion"`
+	// BuildTimestamp is the newest modification time of the files in the archive, which is when the mod was packaged
+	BuildTimestamp string `json:"buildTimestamp"`
+	IsDebugBuild   bool   `json:"isDebugBuild"`
+}
+
+// archiveBuildTime returns the newest modification time of the files in the archive
+func archiveBuildTime(archivePath string) (time.Time, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	var buildTime time.Time
+	for _, file := range archive.File {
+		if file.Modified.After(buildTime) {
+			buildTime = file.Modified
+		}
+	}
+	return buildTime, nil
+}
+
+// smlGameVersionRange returns the game version constraint supported by the SML versions matching the constraint,
+// empty if none match or one of them supports any game version
+func (a *app) smlGameVersionRange(smlCondition string) (string, error) {
+	constraint, err := semver.NewConstraint(smlCondition)
+	if err != nil {
+		return "", fmt.Errorf("invalid SML version constraint %s: %w", smlCondition, err)
+	}
+	matrix, err := a.GetSMLCompatibilityMatrix()
+	if err != nil {
+		return "", err
+	}
+	gameVersions := make([]string, 0, len(matrix.Entries))
+	for _, entry := range matrix.Entries {
+		version, err := semver.NewVersion(entry.SMLVersion)
+		if err != nil || !constraint.Check(version) {
+			continue
+		}
+		if entry.GameVersion == "" {
+			return "", nil
+		}
+		if !slices.Contains(gameVersions, entry.GameVersion) {
+			gameVersions = append(gameVersions, entry.GameVersion)
+		}
+	}
+	return strings.Join(gameVersions, " || "), nil
+}
+
+// GetModBuildInfo describes what a mod version was built against, so mismatches with the installation can be spotted.
+// Mod archives have no build metadata file, so this is put together from the ficsit.app version data, the uplugin and the archive's DLLs.
+// An empty version means the installed version.
+func (a *app) GetModBuildInfo(modID, version string) (BuildInfo, error) {
+	if modID == "" {
+		return BuildInfo{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	if version == "" {
+		var err error
+		version, err = installedModVersion(modID)
+		if err != nil {
+			return BuildInfo{}, err
+		}
+	}
+	modVersion, err := ficsitcli.FicsitCLI.GetModVersion(modID, version)
+	if err != nil {
+		return BuildInfo{}, fmt.Errorf("failed to get mod version: %w", err)
+	}
+	info := BuildInfo{
+		TargetGameVersion: modVersion.GameVersion,
+	}
+	for _, dependency := range modVersion.Dependencies {
+		if dependency.ModID == "SML" {
+			info.TargetSMLVersion = dependency.Condition
+		}
+	}
+	if info.TargetGameVersion == "" && info.TargetSMLVersion != "" {
+		info.TargetGameVersion, err = a.smlGameVersionRange(info.TargetSMLVersion)
+		if err != nil {
+			slog.Warn("failed to get game versions supported by SML", slog.String("condition", info.TargetSMLVersion), slog.Any("error", err))
+		}
+	}
+
+	var buildTime time.Time
+	var assemblies []AssemblyInfo
+	err = ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		uplugin, err := getModUPlugin(modID, archivePath)
+		if err != nil {
+			slog.Warn("failed to read mod uplugin", slog.String("mod", modID), slog.Any("error", err))
+		} else {
+			info.UnrealEngineVersion = uplugin.EngineVersion
+		}
+
+		buildTime, err = archiveBuildTime(archivePath)
+		if err != nil {
+			return err
+		}
+		assemblies, err = getModAssemblies(archivePath)
+		return err
+	})
+	if err != nil {
+		return BuildInfo{}, err //nolint:wrapcheck
+	}
+	if !buildTime.IsZero() {
+		info.BuildTimestamp = buildTime.UTC().Format(time.RFC3339)
+	}
+	for _, assembly := range assemblies {
+		// UE names the binaries of non-shipping builds like UnrealGame-Module-Win64-DebugGame.dll
+		name := strings.ToLower(path.Base(assembly.FileName))
+		if strings.Contains(name, "-debuggame") || strings.Contains(name, "-debug.") {
+			info.IsDebugBuild = true
+			break
+		}
+	}
+	return info, nil
+}
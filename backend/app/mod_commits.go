@@ -0,0 +1,129 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type CommitSummary struct {
+	SHA     string    `json:"sha"`
+	Message string    `json:"message"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+}
+
+const modCommitHistoryLength = 20
+
+var modCommitHistoryCache = utils.NewTTLCache[string, []CommitSummary](1 * time.Hour)
+
+// getJSON decodes the response of a GET request. Requests to the GitHub API are authenticated with the GitHub token, if one is set
+func getJSON(requestURL string, v interface{}) error {
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	isGitHubAPI := request.URL.Host == "api.github.com"
+	if isGitHubAPI {
+		setGitHubHeaders(request)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", requestURL, err)
+	}
+	defer response.Body.Close()
+	if isGitHubAPI && isGitHubRateLimited(response) {
+		return fmt.Errorf("failed to get %s: %w", requestURL, errGitHubRateLimited)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s: %s", requestURL, response.Status)
+	}
+	err = json.NewDecoder(response.Body).Decode(v)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", requestURL, err)
+	}
+	return nil
+}
+
+// commitTitle is the first line of the commit message
+func commitTitle(message string) string {
+	title, _, _ := strings.Cut(message, "\n")
+	return strings.TrimSpace(title)
+}
+
+func fetchGitHubCommits(repoPath string) ([]CommitSummary, error) {
+	var data []struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message string `json:"message"`
+			Author  struct {
+				Name string    `json:"name"`
+				Date time.Time `json:"date"`
+			} `json:"author"`
+		} `json:"commit"`
+	}
+	err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/commits?per_page=%d", repoPath, modCommitHistoryLength), &data)
+	if err != nil {
+		return nil, err
+	}
+	commits := make([]CommitSummary, 0, len(data))
+	for _, commit := range data {
+		commits = append(commits, CommitSummary{
+			SHA:     commit.SHA,
+			Message: commitTitle(commit.Commit.Message),
+			Author:  commit.Commit.Author.Name,
+			Date:    commit.Commit.Author.Date,
+		})
+	}
+	return commits, nil
+}
+
+func fetchGitLabCommits(repoPath string) ([]CommitSummary, error) {
+	var data []struct {
+		ID         string    `json:"id"`
+		Message    string    `json:"message"`
+		AuthorName string    `json:"author_name"`
+		AuthoredAt time.Time `json:"authored_date"`
+	}
+	err := getJSON(fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/repository/commits?per_page=%d", url.PathEscape(repoPath), modCommitHistoryLength), &data)
+	if err != nil {
+		return nil, err
+	}
+	commits := make([]CommitSummary, 0, len(data))
+	for _, commit := range data {
+		commits = append(commits, CommitSummary{
+			SHA:     commit.ID,
+			Message: commitTitle(commit.Message),
+			Author:  commit.AuthorName,
+			Date:    commit.AuthoredAt,
+		})
+	}
+	return commits, nil
+}
+
+// GetModCommitHistory returns the latest commits of the default branch of the mod's source repository, newest first.
+// Only GitHub and GitLab repositories are supported, other source URLs return ErrNoSourceURL.
+func (a *app) GetModCommitHistory(modID string) ([]CommitSummary, error) {
+	return modCommitHistoryCache.GetOrCompute(modID, func() ([]CommitSummary, error) {
+		sourceURL, err := a.GetModSourceURL(modID)
+		if err != nil {
+			return nil, err
+		}
+		repo, ok := parseSourceRepository(sourceURL)
+		if !ok {
+			return nil, ErrNoSourceURL
+		}
+		switch repo.Host {
+		case repositoryHostGitHub:
+			return fetchGitHubCommits(repo.Path)
+		case repositoryHostGitLab:
+			return fetchGitLabCommits(repo.Path)
+		}
+		return nil, ErrNoSourceURL
+	})
+}
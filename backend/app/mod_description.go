@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const getModDescriptionQuery = `query GetModDescription($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    full_description
+  }
+}`
+
+// Descriptions can be edited without releasing a new version, so they are not cached permanently
+var modDescriptionCache = utils.NewTTLCache[string, string](1 * time.Hour)
+
+var (
+	htmlTagNameRegex   = regexp.MustCompile(`^</?([a-zA-Z][a-zA-Z0-9-]*)`)
+	htmlAttributeRegex = regexp.MustCompile(`([a-zA-Z-]+)\s*=\s*("[^"]*"|'[^']*')`)
+)
+
+// Attributes kept on allowed tags, event handlers and styles are always removed
+var allowedHTMLAttributes = []string{"src", "alt", "title", "width", "height", "open"}
+
+// sanitizeHTMLTag rebuilds the tag with only its allowed attributes, or removes it if the tag is not allowed
+func sanitizeHTMLTag(tag string, allowedTags []string) string {
+	nameMatch := htmlTagNameRegex.FindStringSubmatch(tag)
+	if nameMatch == nil || !slices.Contains(allowedTags, strings.ToLower(nameMatch[1])) {
+		return ""
+	}
+	name := strings.ToLower(nameMatch[1])
+	if strings.HasPrefix(tag, "</") {
+		return "</" + name + ">"
+	}
+
+	var result strings.Builder
+	result.WriteString("<" + name)
+	for _, attribute := range htmlAttributeRegex.FindAllStringSubmatch(tag, -1) {
+		attributeName := strings.ToLower(attribute[1])
+		if !slices.Contains(allowedHTMLAttributes, attributeName) {
+			continue
+		}
+		value := strings.Trim(attribute[2], `"'`)
+		if attributeName == "src" && !strings.HasPrefix(strings.ToLower(value), "https://") {
+			continue
+		}
+		result.WriteString(fmt.Sprintf(` %s="%s"`, attributeName, strings.ReplaceAll(value, `"`, "&quot;")))
+	}
+	result.WriteString(">")
+	return result.String()
+}
+
+// sanitizeMarkdownWithAllowlist is sanitizeMarkdown, but keeps the allowed HTML tags
+func sanitizeMarkdownWithAllowlist(s string, allowedTags []string) string {
+	s = unsafeElementsRegex.ReplaceAllString(s, "")
+	s = markdownHTMLTagRegex.ReplaceAllStringFunc(s, func(tag string) string {
+		return sanitizeHTMLTag(tag, allowedTags)
+	})
+	return strings.TrimSpace(s)
+}
+
+// GetModMarkdownDescription returns the full ficsit.app description of the mod,
+// with any HTML other than the tags in markdown-allowed-html-tags removed
+func (a *app) GetModMarkdownDescription(modID string) (string, error) {
+	if modID == "" {
+		return "", fmt.Errorf("mod ID cannot be empty")
+	}
+	return modDescriptionCache.GetOrCompute(modID, func() (string, error) {
+		var response struct {
+			Mod *struct {
+				FullDescription string `json:"full_description"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModDescriptionQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return "", err
+		}
+		if response.Mod == nil {
+			return "", fmt.Errorf("mod %s not found", modID)
+		}
+		return sanitizeMarkdownWithAllowlist(response.Mod.FullDescription, viper.GetStringSlice("markdown-allowed-html-tags")), nil
+	})
+}
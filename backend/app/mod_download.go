@@ -0,0 +1,49 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type modDownloadURLCacheKey struct {
+	installPath string
+	modID       string
+	version     string
+}
+
+// Published versions keep their download URL, so this never expires.
+// The installation is part of the key, since the URL depends on its platform.
+var modDownloadURLCache = utils.NewTTLCache[modDownloadURLCacheKey, string](0)
+
+// GetModDownloadURL returns the URL the selected installation would download the mod version from, without downloading it.
+// An empty version means the installed version.
+func (a *app) GetModDownloadURL(modID, version string) (string, error) {
+	if modID == "" {
+		return "", fmt.Errorf("mod ID cannot be empty")
+	}
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return "", ErrGameDirectoryNotFound
+	}
+	if version == "" {
+		var err error
+		version, err = installedModVersion(modID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return modDownloadURLCache.GetOrCompute(modDownloadURLCacheKey{selectedInstall.Path, modID, version}, func() (string, error) {
+		link, err := ficsitcli.FicsitCLI.GetModDownloadLink(modID, version)
+		if err != nil {
+			return "", fmt.Errorf("failed to get download link: %w", err)
+		}
+		parsed, err := validateHTTPSURL(link)
+		if err != nil {
+			return "", err
+		}
+		return parsed.String(), nil
+	})
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+// GetModFileHash returns the SHA-256 of the mod version's archive for the selected installation, as sha256:<hex>.
+// An empty version means the installed version.
+func (a *app) GetModFileHash(modID, version string) (string, error) {
+	if modID == "" {
+		return "", fmt.Errorf("mod ID cannot be empty")
+	}
+	hash, err := ficsitcli.FicsitCLI.GetModArchiveHash(modID, version)
+	if err != nil {
+		return "", fmt.Errorf("failed to get archive hash: %w", err)
+	}
+	return "sha256:" + strings.ToLower(hash), nil
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+var (
+	ErrGitHubTokenRequired = fmt.Errorf("a GitHub token is required")
+	errGitHubRateLimited   = fmt.Errorf("GitHub rate limit reached")
+)
+
+// The GitHub search API allows 30 authenticated requests per minute
+const githubSearchInterval = 2 * time.Second
+
+// setGitHubHeaders authenticates the request with the GitHub token, if one is set
+func setGitHubHeaders(request *http.Request) {
+	request.Header.Set("Accept", "application/vnd.github+json")
+	if token := settings.GetGitHubToken(); token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// isGitHubRateLimited tells rate limit responses apart from other 403s, like a token without access to the repository
+func isGitHubRateLimited(response *http.Response) bool {
+	if response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	// The secondary rate limits only set Retry-After
+	return response.StatusCode == http.StatusForbidden &&
+		(response.Header.Get("X-RateLimit-Remaining") == "0" || response.Header.Get("Retry-After") != "")
+}
+
+// Keyed by GitHub owner/repo
+var openBugCountCache = utils.NewTTLCache[string, int](1 * time.Hour)
+
+func fetchGitHubOpenBugCount(repoPath string) (int, error) {
+	query := url.QueryEscape(fmt.Sprintf("repo:%s is:issue is:open label:bug", repoPath))
+	request, err := http.NewRequest(http.MethodGet, "https://api.github.com/search/issues?per_page=1&q="+query, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	setGitHubHeaders(request)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search repository issues: %w", err)
+	}
+	defer response.Body.Close()
+	if isGitHubRateLimited(response) {
+		return 0, errGitHubRateLimited
+	}
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to search repository issues: %s", response.Status)
+	}
+
+	var data struct {
+		TotalCount int `json:"total_count"`
+	}
+	err = json.NewDecoder(response.Body).Decode(&data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode issue search: %w", err)
+	}
+	return data.TotalCount, nil
+}
+
+// GetModsWithOpenIssues returns the installed mods whose GitHub repository has open issues labelled bug.
+// This is best effort: mods without a GitHub issue tracker are skipped, and once the GitHub rate limit is reached, the mods found so far are returned.
+func (a *app) GetModsWithOpenIssues() ([]ModSummary, error) {
+	if settings.GetGitHubToken() == "" {
+		return nil, ErrGitHubTokenRequired
+	}
+
+	mods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+	modIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		modIDs = append(modIDs, mod.ModID)
+	}
+	modsData, err := getModsData(modIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mod data: %w", err)
+	}
+
+	result := make([]ModSummary, 0)
+	lastRequest := time.Time{}
+	for _, modID := range modIDs {
+		modData, ok := modsData[modID]
+		if !ok {
+			continue
+		}
+		issueTrackerURL, err := a.GetModIssueTrackerURL(modID)
+		if err != nil {
+			if !errors.Is(err, ErrNoIssueTrackerURL) {
+				slog.Warn("failed to get issue tracker", slog.String("mod", modID), slog.Any("error", err))
+			}
+			continue
+		}
+		repo, ok := parseSourceRepository(issueTrackerURL)
+		if !ok || repo.Host != repositoryHostGitHub {
+			continue
+		}
+
+		openBugCount, err := openBugCountCache.GetOrCompute(repo.Path, func() (int, error) {
+			time.Sleep(time.Until(lastRequest.Add(githubSearchInterval)))
+			lastRequest = time.Now()
+			return fetchGitHubOpenBugCount(repo.Path)
+		})
+		if err != nil {
+			if errors.Is(err, errGitHubRateLimited) {
+				slog.Warn("GitHub rate limit reached, returning partial results")
+				break
+			}
+			slog.Warn("failed to get open bug count", slog.String("mod", modID), slog.Any("error", err))
+			continue
+		}
+		if openBugCount == 0 {
+			continue
+		}
+		summary := toModSummary(modData)
+		summary.OpenBugCount = openBugCount
+		result = append(result, summary)
+	}
+	return result, nil
+}
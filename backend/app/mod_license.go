@@ -0,0 +1,115 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type LicenseInfo struct {
+	SPDX string `json:"spdx"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// AllowsRedistribution is nil when it is not known for the license
+	AllowsRedistribution *bool `json:"allowsRedistribution"`
+}
+
+var unknownLicense = LicenseInfo{Name: "Unknown"}
+
+// Licenses that allow sharing the mod files, possibly with conditions such as keeping the license or source available
+var redistributableLicenses = []string{
+	"MIT", "MIT-0", "ISC", "0BSD", "BSD-2-Clause", "BSD-3-Clause", "Apache-2.0", "Unlicense", "Zlib", "CC0-1.0",
+	"MPL-2.0", "LGPL-2.1", "LGPL-3.0", "GPL-2.0", "GPL-3.0", "AGPL-3.0",
+	"CC-BY-4.0", "CC-BY-SA-4.0", "CC-BY-NC-4.0", "CC-BY-NC-SA-4.0",
+}
+
+// Licenses that do not allow sharing modified or unmodified copies
+var nonRedistributableLicenses = []string{
+	"CC-BY-ND-4.0", "CC-BY-NC-ND-4.0",
+}
+
+var modLicenseCache = utils.NewTTLCache[string, LicenseInfo](1 * time.Hour)
+
+func allowsRedistribution(spdx string) *bool {
+	for _, license := range redistributableLicenses {
+		if strings.EqualFold(license, spdx) {
+			allowed := true
+			return &allowed
+		}
+	}
+	for _, license := range nonRedistributableLicenses {
+		if strings.EqualFold(license, spdx) {
+			allowed := false
+			return &allowed
+		}
+	}
+	return nil
+}
+
+func fetchGitHubLicense(repoPath string) (LicenseInfo, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/repos/%s/license", repoPath), nil)
+	if err != nil {
+		return LicenseInfo{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	setGitHubHeaders(request)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return LicenseInfo{}, fmt.Errorf("failed to get repository license: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return unknownLicense, nil
+	}
+	if isGitHubRateLimited(response) {
+		return LicenseInfo{}, errGitHubRateLimited
+	}
+	if response.StatusCode != http.StatusOK {
+		return LicenseInfo{}, fmt.Errorf("failed to get repository license: %s", response.Status)
+	}
+
+	var data struct {
+		HTMLURL string `json:"html_url"`
+		License struct {
+			SPDXID string `json:"spdx_id"`
+			Name   string `json:"name"`
+		} `json:"license"`
+	}
+	err = json.NewDecoder(response.Body).Decode(&data)
+	if err != nil {
+		return LicenseInfo{}, fmt.Errorf("failed to decode repository license: %w", err)
+	}
+	// GitHub uses NOASSERTION for license files it cannot identify
+	if data.License.SPDXID == "" || data.License.SPDXID == "NOASSERTION" {
+		return LicenseInfo{Name: "Unknown", URL: data.HTMLURL}, nil
+	}
+	return LicenseInfo{
+		SPDX:                 data.License.SPDXID,
+		Name:                 data.License.Name,
+		URL:                  data.HTMLURL,
+		AllowsRedistribution: allowsRedistribution(data.License.SPDXID),
+	}, nil
+}
+
+// GetModLicenseInfo returns the license of the mod's source repository.
+// ficsit.app does not store mod licenses, so only mods with a GitHub source URL have a known license.
+func (a *app) GetModLicenseInfo(modID string) (LicenseInfo, error) {
+	return modLicenseCache.GetOrCompute(modID, func() (LicenseInfo, error) {
+		sourceURL, err := a.GetModSourceURL(modID)
+		if err != nil {
+			if errors.Is(err, ErrNoSourceURL) {
+				return unknownLicense, nil
+			}
+			return LicenseInfo{}, err
+		}
+		repo, ok := parseSourceRepository(sourceURL)
+		if !ok || repo.Host != repositoryHostGitHub {
+			return unknownLicense, nil
+		}
+		return fetchGitHubLicense(repo.Path)
+	})
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+var (
+	ErrNoSourceURL       = fmt.Errorf("mod has no source URL")
+	ErrNoIssueTrackerURL = fmt.Errorf("mod has no known issue tracker")
+)
+
+const getModSourceURLQuery = `query GetModSourceURL($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    source_url
+  }
+}`
+
+// Keyed by mod reference, an empty URL means the mod has none set
+var modSourceURLCache = utils.NewTTLCache[string, string](1 * time.Hour)
+
+func validateHTTPSURL(rawURL string) (*url.URL, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, fmt.Errorf("not an HTTPS URL: %s", rawURL)
+	}
+	return parsed, nil
+}
+
+type repositoryHost string
+
+const (
+	repositoryHostGitHub repositoryHost = "github"
+	repositoryHostGitLab repositoryHost = "gitlab"
+)
+
+type sourceRepository struct {
+	Host repositoryHost
+	// Path is owner/repo for GitHub, and can include nested groups for GitLab
+	Path string
+}
+
+// parseSourceRepository extracts the repository from a GitHub or GitLab URL,
+// which can also point to a file or branch inside the repository
+func parseSourceRepository(sourceURL string) (sourceRepository, bool) {
+	parsed, err := url.Parse(sourceURL)
+	if err != nil {
+		return sourceRepository{}, false
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	switch strings.ToLower(parsed.Host) {
+	case "github.com", "www.github.com":
+		if len(segments) < 2 {
+			return sourceRepository{}, false
+		}
+		return sourceRepository{
+			Host: repositoryHostGitHub,
+			Path: segments[0] + "/" + strings.TrimSuffix(segments[1], ".git"),
+		}, true
+	case "gitlab.com", "www.gitlab.com":
+		// GitLab repositories can be nested in groups, everything before "/-/" is the repository
+		if idx := slices.Index(segments, "-"); idx != -1 {
+			segments = segments[:idx]
+		}
+		if len(segments) < 2 {
+			return sourceRepository{}, false
+		}
+		segments[len(segments)-1] = strings.TrimSuffix(segments[len(segments)-1], ".git")
+		return sourceRepository{
+			Host: repositoryHostGitLab,
+			Path: strings.Join(segments, "/"),
+		}, true
+	}
+	return sourceRepository{}, false
+}
+
+func (a *app) GetModSourceURL(modID string) (string, error) {
+	sourceURL, err := modSourceURLCache.GetOrCompute(modID, func() (string, error) {
+		var response struct {
+			Mod *struct {
+				SourceURL string `json:"source_url"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModSourceURLQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return "", err
+		}
+		if response.Mod == nil {
+			return "", fmt.Errorf("mod %s not found", modID)
+		}
+		return response.Mod.SourceURL, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if sourceURL == "" {
+		return "", ErrNoSourceURL
+	}
+	parsed, err := validateHTTPSURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// GetModIssueTrackerURL derives the issue tracker from the mod's source URL.
+// ficsit.app has no issue tracker field, so only GitHub and GitLab repositories are supported.
+// The source URL is cached, so this is cached for as long as that is.
+func (a *app) GetModIssueTrackerURL(modID string) (string, error) {
+	sourceURL, err := a.GetModSourceURL(modID)
+	if err != nil {
+		if errors.Is(err, ErrNoSourceURL) {
+			return "", ErrNoIssueTrackerURL
+		}
+		return "", err
+	}
+	repo, ok := parseSourceRepository(sourceURL)
+	if !ok {
+		return "", ErrNoIssueTrackerURL
+	}
+	switch repo.Host {
+	case repositoryHostGitHub:
+		return "https://github.com/" + repo.Path + "/issues", nil
+	case repositoryHostGitLab:
+		return "https://gitlab.com/" + repo.Path + "/-/issues", nil
+	}
+	return "", ErrNoIssueTrackerURL
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type modDependenciesCacheKey struct {
+	// The resolved versions depend on the game version of the installation
+	installPath string
+	modID       string
+	version     string
+}
+
+var modDependenciesCache = utils.NewTTLCache[modDependenciesCacheKey, []string](30 * time.Minute)
+
+// getModDependencies resolves the mod on its own for the selected installation,
+// returning every mod it depends on, directly or transitively, sorted by mod reference
+func getModDependencies(modID, version string) ([]string, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	if version == "" {
+		version = ">=0.0.0"
+	}
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return nil, fmt.Errorf("no installation selected")
+	}
+
+	return modDependenciesCache.GetOrCompute(modDependenciesCacheKey{selectedInstall.Path, modID, version}, func() ([]string, error) {
+		lockfile, err := ficsitcli.FicsitCLI.ResolveModsForSelectedInstall(map[string]string{
+			modID: version,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve dependencies: %w", err)
+		}
+
+		dependencies := maps.Keys(lockfile.Mods)
+		dependencies = slices.DeleteFunc(dependencies, func(dependency string) bool {
+			return dependency == modID
+		})
+		slices.Sort(dependencies)
+		return dependencies, nil
+	})
+}
+
+// GetModPackContents returns every mod that installing the mod pack would install, including transitive dependencies, sorted by mod reference.
+// ficsit.app has no separate mod pack type, a mod pack is a mod that depends on the mods of the pack,
+// so this works for any mod. An empty version means the latest version compatible with the selected installation.
+// GetDependencyResolutionPlan already includes the contents of any mod pack passed to it.
+func (a *app) GetModPackContents(modPackID, version string) ([]string, error) {
+	dependencies, err := getModDependencies(modPackID, version)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(dependencies), nil
+}
+
+// GetModDependencyCount returns how many mods the latest version of the mod directly requires, optional dependencies excluded.
+// It only uses the ficsit.app version data, so it does not need an installation.
+func (a *app) GetModDependencyCount(modID string) (int, error) {
+	if modID == "" {
+		return 0, fmt.Errorf("mod ID cannot be empty")
+	}
+	latest, err := latestModVersion(modID)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, dependency := range latest.Dependencies {
+		if !dependency.Optional {
+			count++
+		}
+	}
+	return count, nil
+}
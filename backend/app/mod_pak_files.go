@@ -0,0 +1,215 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type PakFileInfo struct {
+	FileName string `json:"fileName"`
+	// MountPoint is empty if it could not be read, such as for paks with an encrypted index
+	MountPoint string `json:"mountPoint"`
+	SizeBytes  int64  `json:"sizeBytes"`
+	IsOptional bool   `json:"isOptional"`
+	// ConflictingMods are the other installed mods with a pak at the same mount point
+	ConflictingMods []string `json:"conflictingMods,omitempty"`
+}
+
+const (
+	pakMagic = 0x5A6F12E1
+	// The footer is at most 221 bytes, depending on the pak version
+	pakFooterSearchSize = 512
+	// Mount points are short relative paths, anything longer is a misread
+	maxPakMountPointLength = 1024
+)
+
+// Keyed by archive path, which is unique per mod version and target, and archives never change
+var modPakFilesCache = utils.NewTTLCache[string, []PakFileInfo](0)
+
+// openZipFileAt opens the file in the archive and skips to offset.
+// Zip entries are usually compressed, so they cannot be seeked.
+func openZipFileAt(file *zip.File, offset int64) (io.ReadCloser, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	_, err = io.CopyN(io.Discard, reader, offset)
+	if err != nil {
+		reader.Close()
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	return reader, nil
+}
+
+// readPakMountPoint reads the mount point from the pak's index, which the footer at the end of the pak points to
+func readPakMountPoint(file *zip.File) (string, error) {
+	size := int64(file.UncompressedSize64)
+	tailSize := min(size, pakFooterSearchSize)
+	reader, err := openZipFileAt(file, size-tailSize)
+	if err != nil {
+		return "", err
+	}
+	tail, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+
+	magic := binary.LittleEndian.AppendUint32(nil, pakMagic)
+	footerStart := bytes.LastIndex(tail, magic)
+	// The magic is followed by the version, the index offset and the index size
+	if footerStart < 0 || footerStart+4+4+8+8 > len(tail) {
+		return "", fmt.Errorf("%s is not a pak file", file.Name)
+	}
+	// The byte before the magic is the encrypted index flag, from pak version 4 on
+	if footerStart > 0 && tail[footerStart-1] != 0 && binary.LittleEndian.Uint32(tail[footerStart+4:]) >= 4 {
+		return "", fmt.Errorf("%s has an encrypted index", file.Name)
+	}
+	indexOffset := int64(binary.LittleEndian.Uint64(tail[footerStart+8:]))
+	if indexOffset < 0 || indexOffset >= size {
+		return "", fmt.Errorf("%s has an invalid index offset", file.Name)
+	}
+
+	reader, err = openZipFileAt(file, indexOffset)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	return readUnrealString(reader)
+}
+
+// readUnrealString reads an FString: its length including the terminator, negative for UTF-16, then its characters
+func readUnrealString(reader io.Reader) (string, error) {
+	var length int32
+	err := binary.Read(reader, binary.LittleEndian, &length)
+	if err != nil {
+		return "", fmt.Errorf("failed to read string length: %w", err)
+	}
+	switch {
+	case length == 0:
+		return "", nil
+	case length > 0 && length <= maxPakMountPointLength:
+		data := make([]byte, length)
+		_, err := io.ReadFull(reader, data)
+		if err != nil {
+			return "", fmt.Errorf("failed to read string: %w", err)
+		}
+		return string(bytes.TrimRight(data, "\x00")), nil
+	case length < 0 && -length <= maxPakMountPointLength:
+		data := make([]uint16, -length)
+		err := binary.Read(reader, binary.LittleEndian, data)
+		if err != nil {
+			return "", fmt.Errorf("failed to read string: %w", err)
+		}
+		return strings.TrimRight(string(utf16.Decode(data)), "\x00"), nil
+	default:
+		return "", fmt.Errorf("invalid string length %d", length)
+	}
+}
+
+func getModPakFiles(archivePath string) ([]PakFileInfo, error) {
+	return modPakFilesCache.GetOrCompute(archivePath, func() ([]PakFileInfo, error) {
+		archive, err := zip.OpenReader(archivePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open mod archive: %w", err)
+		}
+		defer archive.Close()
+
+		paks := make([]PakFileInfo, 0)
+		for _, file := range archive.File {
+			if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".pak") {
+				continue
+			}
+			mountPoint, err := readPakMountPoint(file)
+			if err != nil {
+				slog.Warn("failed to read pak mount point", slog.String("archive", archivePath), slog.String("file", file.Name), slog.Any("error", err))
+			}
+			paks = append(paks, PakFileInfo{
+				FileName:   file.Name,
+				MountPoint: mountPoint,
+				SizeBytes:  int64(file.UncompressedSize64),
+				// Optional paks are named like pakchunk0optional-Windows.pak
+				IsOptional: strings.Contains(strings.ToLower(path.Base(file.Name)), "optional"),
+			})
+		}
+		return paks, nil
+	})
+}
+
+// installedPakMountPoints returns the mount points of the paks of the other installed mods, keyed by mount point.
+// Only mods with a cached archive are checked, so nothing is downloaded.
+func installedPakMountPoints(exceptModID string) (map[string][]string, error) {
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	mountPoints := make(map[string][]string)
+	for modID, lockedMod := range lockfileMods {
+		if modID == exceptModID {
+			continue
+		}
+		archivePath, err := ficsitcli.FicsitCLI.GetCachedModArchivePath(modID, lockedMod.Version)
+		if err != nil || archivePath == "" {
+			continue
+		}
+		paks, err := getModPakFiles(archivePath)
+		if err != nil {
+			slog.Warn("failed to read pak files", slog.String("mod", modID), slog.Any("error", err))
+			continue
+		}
+		for _, pak := range paks {
+			if pak.MountPoint != "" && !slices.Contains(mountPoints[pak.MountPoint], modID) {
+				mountPoints[pak.MountPoint] = append(mountPoints[pak.MountPoint], modID)
+			}
+		}
+	}
+	for _, modIDs := range mountPoints {
+		slices.Sort(modIDs)
+	}
+	return mountPoints, nil
+}
+
+// GetModPakFiles returns the .pak files in a mod version's archive, with the mount point read from each pak's index.
+// Paks mounted at the same point as a pak of another installed mod list that mod in ConflictingMods.
+// An empty version means the installed version.
+func (a *app) GetModPakFiles(modID, version string) ([]PakFileInfo, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	var paks []PakFileInfo
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		paks, err = getModPakFiles(archivePath)
+		return err
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	mountPoints, err := installedPakMountPoints(modID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]PakFileInfo, 0, len(paks))
+	for _, pak := range paks {
+		if pak.MountPoint != "" {
+			pak.ConflictingMods = mountPoints[pak.MountPoint]
+		}
+		if len(pak.ConflictingMods) > 0 {
+			slog.Warn("pak mount point collision", slog.String("mod", modID), slog.String("mountPoint", pak.MountPoint), slog.Any("mods", pak.ConflictingMods))
+		}
+		result = append(result, pak)
+	}
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type SecurityInfo struct {
+	// HasCSharpCode is set if the archive has .NET assemblies
+	HasCSharpCode bool `json:"hasCSharpCode"`
+	// HasNativeCode is set if the archive has native DLLs, or the uplugin declares C++ modules
+	HasNativeCode bool `json:"hasNativeCode"`
+	// RequestsNetworkAccess is set if the uplugin depends on one of the engine's networking plugins, or declares a networking module
+	RequestsNetworkAccess bool `json:"requestsNetworkAccess"`
+	// OverridesGameFiles is set if a pak is mounted outside the mod's own directory
+	OverridesGameFiles bool `json:"overridesGameFiles"`
+	// IsApproved is set if ficsit.app approved the version, which happens after its virus scan.
+	// It is nil if the approval could not be checked.
+	IsApproved *bool `json:"isApproved"`
+	// RiskLevel is low, medium or high, or unknown if it depends on the approval and that could not be checked
+	RiskLevel string `json:"riskLevel"`
+}
+
+// Engine plugins that give a mod its own network connections
+var networkPlugins = []string{"http", "websockets", "sockets", "networking", "onlinesubsystem", "onlinesubsystemutils", "xmpp"}
+
+// Module names that suggest networking code, compared lowercase
+var networkModuleHints = []string{"http", "socket", "websocket", "network", "download", "telemetry"}
+
+const getModVersionApprovedQuery = `query GetModVersionApproved($modReference: ModReference!, $version: String!) {
+  getModByReference(modReference: $modReference) {
+    version(version: $version) {
+      approved
+    }
+  }
+}`
+
+type modVersionCacheKey struct {
+	modID   string
+	version string
+}
+
+// Approval can change as ficsit.app reviews versions, so it is not kept for long
+var modVersionApprovedCache = utils.NewTTLCache[modVersionCacheKey, bool](1 * time.Hour)
+
+func isModVersionApproved(modID, version string) (bool, error) {
+	return modVersionApprovedCache.GetOrCompute(modVersionCacheKey{modID, version}, func() (bool, error) {
+		var response struct {
+			Mod *struct {
+				Version *struct {
+					Approved bool `json:"approved"`
+				} `json:"version"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModVersionApprovedQuery, map[string]interface{}{
+			"modReference": modID,
+			"version":      version,
+		}, &response)
+		if err != nil {
+			return false, err
+		}
+		if response.Mod == nil || response.Mod.Version == nil {
+			return false, fmt.Errorf("version %s of %s not found", version, modID)
+		}
+		return response.Mod.Version.Approved, nil
+	})
+}
+
+func securityRiskLevel(info SecurityInfo) string {
+	hasCode := info.HasCSharpCode || info.HasNativeCode
+	switch {
+	case hasCode && info.RequestsNetworkAccess:
+		return "high"
+	case info.IsApproved == nil:
+		return "unknown"
+	case !*info.IsApproved && (hasCode || info.OverridesGameFiles):
+		return "high"
+	case !*info.IsApproved || hasCode || info.RequestsNetworkAccess || info.OverridesGameFiles:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// GetModSecurityInfo summarizes what a mod version can do, from its archive contents and its ficsit.app approval.
+// This is a heuristic overview for the user, not a security scan. An empty version means the installed version.
+func (a *app) GetModSecurityInfo(modID, version string) (SecurityInfo, error) {
+	if modID == "" {
+		return SecurityInfo{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	if version == "" {
+		var err error
+		version, err = installedModVersion(modID)
+		if err != nil {
+			return SecurityInfo{}, err
+		}
+	}
+
+	var info SecurityInfo
+	assemblies, err := a.GetModCSharpAssemblies(modID, version)
+	if err != nil {
+		return SecurityInfo{}, err
+	}
+	for _, assembly := range assemblies {
+		if assembly.IsManaged {
+			info.HasCSharpCode = true
+		} else {
+			info.HasNativeCode = true
+		}
+	}
+
+	paks, err := a.GetModPakFiles(modID, version)
+	if err != nil {
+		return SecurityInfo{}, err
+	}
+	ownMountPoint := strings.ToLower("/Mods/" + modID + "/")
+	for _, pak := range paks {
+		if pak.MountPoint != "" && !strings.Contains(strings.ToLower(pak.MountPoint), ownMountPoint) {
+			info.OverridesGameFiles = true
+		}
+	}
+
+	uplugin, err := a.GetModUPlugin(modID, version)
+	if err != nil {
+		slog.Warn("failed to read mod uplugin", slog.String("mod", modID), slog.Any("error", err))
+	} else {
+		info.HasNativeCode = info.HasNativeCode || len(uplugin.Modules) > 0
+		for _, module := range uplugin.Modules {
+			name := strings.ToLower(module.Name)
+			if slices.ContainsFunc(networkModuleHints, func(hint string) bool { return strings.Contains(name, hint) }) {
+				info.RequestsNetworkAccess = true
+			}
+		}
+		for _, plugin := range uplugin.Plugins {
+			if plugin.Enabled && slices.Contains(networkPlugins, strings.ToLower(plugin.Name)) {
+				info.RequestsNetworkAccess = true
+			}
+		}
+	}
+
+	approved, err := isModVersionApproved(modID, version)
+	if err != nil {
+		slog.Warn("failed to get mod version approval", slog.String("mod", modID), slog.String("version", version), slog.Any("error", err))
+	} else {
+		info.IsApproved = &approved
+	}
+
+	info.RiskLevel = securityRiskLevel(info)
+	return info, nil
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type SizeSnapshot struct {
+	Version     string    `json:"version"`
+	ReleaseDate time.Time `json:"releaseDate"`
+	SizeBytes   int64     `json:"sizeBytes"`
+}
+
+const getModVersionSizesQuery = `query GetModVersionSizes($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    versions(filter: { limit: 100, order_by: created_at, order: desc }) {
+      version
+      size
+      created_at
+    }
+  }
+}`
+
+// Keyed by mod reference. Released versions do not change, so this never expires,
+// versions released while SMM is running only show up after a restart.
+var modSizeHistoryCache = utils.NewTTLCache[string, []SizeSnapshot](0)
+
+// GetModSizeHistory returns the archive size of the latest 100 versions of the mod, oldest first
+func (a *app) GetModSizeHistory(modID string) ([]SizeSnapshot, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	return modSizeHistoryCache.GetOrCompute(modID, func() ([]SizeSnapshot, error) {
+		var response struct {
+			Mod *struct {
+				Versions []struct {
+					Version   string    `json:"version"`
+					Size      int64     `json:"size"`
+					CreatedAt time.Time `json:"created_at"`
+				} `json:"versions"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModVersionSizesQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		if response.Mod == nil {
+			return nil, fmt.Errorf("mod %s not found", modID)
+		}
+		history := make([]SizeSnapshot, 0, len(response.Mod.Versions))
+		for _, version := range response.Mod.Versions {
+			history = append(history, SizeSnapshot{
+				Version:     version.Version,
+				ReleaseDate: version.CreatedAt,
+				SizeBytes:   version.Size,
+			})
+		}
+		// Requested newest first, so the limit keeps the latest versions
+		slices.Reverse(history)
+		return history, nil
+	})
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type DownloadStats struct {
+	TotalDownloads int64 `json:"totalDownloads"`
+	// ficsit.app only tracks all-time downloads, so these are not the downloads made in the last month or week.
+	// LastMonthDownloads and LastWeekDownloads are the all-time downloads of the versions created in the last 30 and 7 days.
+	LastMonthDownloads int64 `json:"lastMonthDownloads"`
+	LastWeekDownloads  int64 `json:"lastWeekDownloads"`
+	// Rank is the position of the mod when sorted by total downloads, or 0 if it is not in the top downloadRankLimit
+	Rank int `json:"rank"`
+}
+
+type ficsitVersionDownloads struct {
+	Downloads int64     `json:"downloads"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+const getModDownloadsQuery = `query GetModDownloads($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    downloads
+    versions(filter: { limit: 100 }) {
+      downloads
+      created_at
+    }
+  }
+}`
+
+// Ranks are only computed among the most downloaded mods, to limit the number of requests
+const downloadRankLimit = 500
+
+var (
+	downloadStatsCache = utils.NewTTLCache[string, DownloadStats](1 * time.Hour)
+	downloadRankCache  = utils.NewTTLCache[string, []string](1 * time.Hour)
+)
+
+func getDownloadRanking() ([]string, error) {
+	return downloadRankCache.GetOrCompute("", func() ([]string, error) {
+		ranking := make([]string, 0, downloadRankLimit)
+		for offset := 0; offset < downloadRankLimit; offset += ficsitAPIMaxLimit {
+			mods, count, err := queryMods(map[string]interface{}{
+				"order_by": "downloads",
+				"order":    "desc",
+				"limit":    ficsitAPIMaxLimit,
+				"offset":   offset,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, mod := range mods {
+				ranking = append(ranking, mod.ModReference)
+			}
+			if offset+ficsitAPIMaxLimit >= count {
+				break
+			}
+		}
+		return ranking, nil
+	})
+}
+
+func (a *app) GetModDownloadStats(modID string) (DownloadStats, error) {
+	return downloadStatsCache.GetOrCompute(modID, func() (DownloadStats, error) {
+		var response struct {
+			Mod *struct {
+				Downloads int64                    `json:"downloads"`
+				Versions  []ficsitVersionDownloads `json:"versions"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModDownloadsQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return DownloadStats{}, err
+		}
+		if response.Mod == nil {
+			return DownloadStats{}, fmt.Errorf("mod %s not found", modID)
+		}
+
+		stats := DownloadStats{
+			TotalDownloads: response.Mod.Downloads,
+		}
+
+		now := time.Now()
+		for _, version := range response.Mod.Versions {
+			if version.CreatedAt.After(now.AddDate(0, 0, -30)) {
+				stats.LastMonthDownloads += version.Downloads
+			}
+			if version.CreatedAt.After(now.AddDate(0, 0, -7)) {
+				stats.LastWeekDownloads += version.Downloads
+			}
+		}
+
+		ranking, err := getDownloadRanking()
+		if err != nil {
+			return DownloadStats{}, err
+		}
+		for i, modReference := range ranking {
+			if modReference == modID {
+				stats.Rank = i + 1
+				break
+			}
+		}
+
+		return stats, nil
+	})
+}
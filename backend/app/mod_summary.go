@@ -0,0 +1,67 @@
+package app
+
+import (
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+type ModSummary struct {
+	ModID            string     `json:"modId"`
+	Name             string     `json:"name"`
+	ShortDescription string     `json:"shortDescription"`
+	Logo             string     `json:"logo"`
+	AvatarURL        string     `json:"avatarUrl"`
+	Authors          []string   `json:"authors"`
+	Downloads        int64      `json:"downloads"`
+	Views            int64      `json:"views"`
+	LastVersionDate  *time.Time `json:"lastVersionDate"`
+	IsFavorite       bool       `json:"isFavorite"`
+	// UpdateFrequency is only set once GetModUpdateFrequency has been called for the mod, it needs a query per mod
+	UpdateFrequency float64 `json:"updateFrequency,omitempty"`
+	// OpenBugCount is only set by GetModsWithOpenIssues
+	OpenBugCount int `json:"openBugCount,omitempty"`
+}
+
+func toModSummary(mod ficsitMod) ModSummary {
+	authors := make([]string, 0, len(mod.Authors))
+	for _, author := range mod.Authors {
+		authors = append(authors, author.User.Username)
+	}
+	updateFrequency, _ := modUpdateFrequencyCache.Get(mod.ModReference)
+	return ModSummary{
+		ModID:            mod.ModReference,
+		Name:             mod.Name,
+		ShortDescription: mod.ShortDescription,
+		Logo:             mod.Logo,
+		AvatarURL:        modAvatarURL(mod),
+		Authors:          authors,
+		Downloads:        mod.Downloads,
+		Views:            mod.Views,
+		LastVersionDate:  mod.LastVersionDate,
+		IsFavorite:       isFavorite(mod.ModReference),
+		UpdateFrequency:  updateFrequency,
+	}
+}
+
+func toModSummaries(mods []ficsitMod) []ModSummary {
+	summaries := make([]ModSummary, 0, len(mods))
+	for _, mod := range mods {
+		summaries = append(summaries, toModSummary(mod))
+	}
+	return summaries
+}
+
+// withUserState refreshes the user-specific fields of cached summaries, and drops the hidden mods unless showHidden is set
+func withUserState(summaries []ModSummary, showHidden bool) []ModSummary {
+	result := make([]ModSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		if !showHidden && slices.Contains(settings.Settings.HiddenMods, summary.ModID) {
+			continue
+		}
+		summary.IsFavorite = isFavorite(summary.ModID)
+		result = append(result, summary)
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"archive/zip"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+var ErrNoModTranslations = fmt.Errorf("mod has no translations for this locale")
+
+var localeRegex = regexp.MustCompile(`^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{2,8})*$`)
+
+// GetModTranslations returns the translations/<locale>.json key-value map bundled in the mod version's archive.
+// An empty version means the installed version.
+func (a *app) GetModTranslations(modID, version, locale string) (map[string]string, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	if !localeRegex.MatchString(locale) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLocale, locale)
+	}
+
+	var translations map[string]string
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		translations, err = readModTranslations(archivePath, modID, locale)
+		return err
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return translations, nil
+}
+
+func readModTranslations(archivePath, modID, locale string) (map[string]string, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	// Mods are not consistent about the case of the directory
+	translationsPath := "translations/" + locale + ".json"
+	for _, file := range archive.File {
+		if !strings.EqualFold(file.Name, translationsPath) {
+			continue
+		}
+		translations := make(map[string]string)
+		err := readZipJSON(file, &translations)
+		if err != nil {
+			return nil, err
+		}
+		return translations, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoModTranslations, modID, locale)
+}
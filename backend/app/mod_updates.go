@@ -0,0 +1,106 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type ModUpdateSummary struct {
+	ModID          string `json:"modId"`
+	CurrentVersion string `json:"currentVersion"`
+	LatestVersion  string `json:"latestVersion"`
+	// DownloadSize is 0 if the size could not be fetched from ficsit.app
+	DownloadSize int64 `json:"downloadSize"`
+}
+
+const getModVersionSizeQuery = `query GetModVersionSize($modReference: ModReference!, $version: String!) {
+  getModByReference(modReference: $modReference) {
+    version(version: $version) {
+      size
+    }
+  }
+}`
+
+// Keyed by the installed mods, so installing or updating mods invalidates it
+var modsNeedingUpdateCache = utils.NewTTLCache[string, []ModUpdateSummary](15 * time.Minute)
+
+func getInstalledModsFingerprint() (string, error) {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return "", nil
+	}
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return "", fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	entries := make([]string, 0, len(lockfileMods))
+	for modReference, lockedMod := range lockfileMods {
+		entries = append(entries, modReference+"@"+lockedMod.Version)
+	}
+	slices.Sort(entries)
+	return selectedInstall.Path + "|" + selectedInstall.Profile + "|" + strings.Join(entries, ","), nil
+}
+
+func getModVersionSize(modReference, version string) (int64, error) {
+	var response struct {
+		Mod *struct {
+			Version *struct {
+				Size int64 `json:"size"`
+			} `json:"version"`
+		} `json:"getModByReference"`
+	}
+	err := queryFicsitAPI(getModVersionSizeQuery, map[string]interface{}{
+		"modReference": modReference,
+		"version":      version,
+	}, &response)
+	if err != nil {
+		return 0, err
+	}
+	if response.Mod == nil || response.Mod.Version == nil {
+		return 0, fmt.Errorf("version %s of mod %s not found", version, modReference)
+	}
+	return response.Mod.Version.Size, nil
+}
+
+func (a *app) GetModsNeedingUpdate() ([]ModUpdateSummary, error) {
+	fingerprint, err := getInstalledModsFingerprint()
+	if err != nil {
+		return nil, err
+	}
+	result, err := modsNeedingUpdateCache.GetOrCompute(fingerprint, func() ([]ModUpdateSummary, error) {
+		updates, err := ficsitcli.FicsitCLI.CheckForUpdates()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for updates: %w", err)
+		}
+		summaries := make([]ModUpdateSummary, 0, len(updates))
+		for _, update := range updates {
+			summary := ModUpdateSummary{
+				ModID:          update.Item,
+				CurrentVersion: update.CurrentVersion,
+				LatestVersion:  update.NewVersion,
+			}
+			size, err := getModVersionSize(update.Item, update.NewVersion)
+			if err != nil {
+				slog.Warn("failed to get update download size", slog.String("mod", update.Item), slog.Any("error", err))
+			} else {
+				summary.DownloadSize = size
+			}
+			summaries = append(summaries, summary)
+		}
+		slices.SortFunc(summaries, func(a, b ModUpdateSummary) int {
+			return strings.Compare(a.ModID, b.ModID)
+		})
+		return summaries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	setModUpdatesAvailable(result)
+	return result, nil
+}
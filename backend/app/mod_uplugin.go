@@ -0,0 +1,183 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type UModule struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	LoadingPhase string `json:"loadingPhase"`
+}
+
+type UPluginRef struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Optional bool   `json:"optional"`
+	// SemVersion is the version constraint SML checks, if the plugin is a mod
+	SemVersion string `json:"semVersion"`
+}
+
+// UPlugin is the mod's Unreal plugin descriptor
+type UPlugin struct {
+	// Name is the plugin name, which is the name of the .uplugin file
+	Name         string `json:"name"`
+	FriendlyName string `json:"friendlyName"`
+	Description  string `json:"description"`
+	// Version is the SML SemVersion of the mod, or the UE VersionName if it has none
+	Version       string       `json:"version"`
+	EngineVersion string       `json:"engineVersion"`
+	Category      string       `json:"category"`
+	Modules       []UModule    `json:"modules"`
+	Plugins       []UPluginRef `json:"plugins"`
+	// EngineVersionMismatch is set if the plugin was built for a different UE major.minor version than the selected local installation
+	EngineVersionMismatch bool `json:"engineVersionMismatch"`
+}
+
+// uplugin files use the UE field names
+type upluginFile struct {
+	FriendlyName  string `json:"FriendlyName"`
+	Description   string `json:"Description"`
+	VersionName   string `json:"VersionName"`
+	SemVersion    string `json:"SemVersion"`
+	EngineVersion string `json:"EngineVersion"`
+	Category      string `json:"Category"`
+	Modules       []struct {
+		Name         string `json:"Name"`
+		Type         string `json:"Type"`
+		LoadingPhase string `json:"LoadingPhase"`
+	} `json:"Modules"`
+	Plugins []struct {
+		Name       string `json:"Name"`
+		Enabled    bool   `json:"Enabled"`
+		Optional   bool   `json:"Optional"`
+		SemVersion string `json:"SemVersion"`
+	} `json:"Plugins"`
+}
+
+var ErrNoUPlugin = fmt.Errorf("mod archive has no .uplugin file")
+
+// Keyed by archive path, like the pak files
+var modUPluginCache = utils.NewTTLCache[string, UPlugin](0)
+
+func readUPlugin(file *zip.File) (UPlugin, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return UPlugin{}, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return UPlugin{}, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	// The UE editor saves uplugin files with a BOM
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+
+	var descriptor upluginFile
+	err = json.Unmarshal(data, &descriptor)
+	if err != nil {
+		return UPlugin{}, fmt.Errorf("failed to decode %s: %w", file.Name, err)
+	}
+
+	uplugin := UPlugin{
+		Name:          strings.TrimSuffix(path.Base(file.Name), path.Ext(file.Name)),
+		FriendlyName:  descriptor.FriendlyName,
+		Description:   descriptor.Description,
+		Version:       descriptor.SemVersion,
+		EngineVersion: descriptor.EngineVersion,
+		Category:      descriptor.Category,
+		Modules:       make([]UModule, 0, len(descriptor.Modules)),
+		Plugins:       make([]UPluginRef, 0, len(descriptor.Plugins)),
+	}
+	if uplugin.Version == "" {
+		uplugin.Version = descriptor.VersionName
+	}
+	for _, module := range descriptor.Modules {
+		uplugin.Modules = append(uplugin.Modules, UModule(module))
+	}
+	for _, plugin := range descriptor.Plugins {
+		uplugin.Plugins = append(uplugin.Plugins, UPluginRef(plugin))
+	}
+	return uplugin, nil
+}
+
+func getModUPlugin(modID, archivePath string) (UPlugin, error) {
+	return modUPluginCache.GetOrCompute(archivePath, func() (UPlugin, error) {
+		archive, err := zip.OpenReader(archivePath)
+		if err != nil {
+			return UPlugin{}, fmt.Errorf("failed to open mod archive: %w", err)
+		}
+		defer archive.Close()
+
+		// The descriptor of the mod itself is named after the mod reference, and is at the root of the archive
+		var found *zip.File
+		for _, file := range archive.File {
+			if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".uplugin") {
+				continue
+			}
+			if strings.EqualFold(file.Name, modID+".uplugin") {
+				found = file
+				break
+			}
+			if found == nil {
+				found = file
+			}
+		}
+		if found == nil {
+			return UPlugin{}, fmt.Errorf("%w: %s", ErrNoUPlugin, modID)
+		}
+		return readUPlugin(found)
+	})
+}
+
+// GetModUPlugin parses the .uplugin descriptor in a mod version's archive.
+// An empty version means the installed version.
+func (a *app) GetModUPlugin(modID, version string) (UPlugin, error) {
+	if modID == "" {
+		return UPlugin{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	var uplugin UPlugin
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		uplugin, err = getModUPlugin(modID, archivePath)
+		return err
+	})
+	if err != nil {
+		return UPlugin{}, err //nolint:wrapcheck
+	}
+	uplugin.EngineVersionMismatch = engineVersionMismatch(uplugin.EngineVersion)
+	return uplugin, nil
+}
+
+// engineVersionMismatch compares the major.minor versions, since UE only loads plugins built for the same ones.
+// Remote installations, and plugins without an engine version, are never a mismatch.
+func engineVersionMismatch(pluginEngineVersion string) bool {
+	if pluginEngineVersion == "" {
+		return false
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info == nil || meta.Info.Location != common.LocationTypeLocal {
+		return false
+	}
+	gameEngineVersion, err := common.GetEngineVersion(meta.Info.Path)
+	if err != nil {
+		slog.Warn("failed to get engine version", slog.String("path", meta.Info.Path), slog.Any("error", err))
+		return false
+	}
+	majorMinor := func(version string) string {
+		parts := strings.SplitN(version, ".", 3)
+		return strings.Join(parts[:min(len(parts), 2)], ".")
+	}
+	return majorMinor(pluginEngineVersion) != majorMinor(gameEngineVersion)
+}
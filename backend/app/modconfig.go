@@ -0,0 +1,382 @@
+package app
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/satisfactorymodding/ficsit-cli/cli"
+	"github.com/satisfactorymodding/ficsit-cli/cli/disk"
+	"gopkg.in/ini.v1"
+
+	appCommon "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type modConfigFormat string
+
+const (
+	modConfigFormatJSON modConfigFormat = "json"
+	modConfigFormatINI  modConfigFormat = "ini"
+)
+
+// SML stores mod configs as JSON in <mod>.cfg, but some mods ship their own .json or .ini files
+var modConfigExtensions = []struct {
+	extension string
+	format    modConfigFormat
+}{
+	{".cfg", modConfigFormatJSON},
+	{".json", modConfigFormatJSON},
+	{".ini", modConfigFormatINI},
+}
+
+var ErrModConfigNotFound = fmt.Errorf("mod config not found")
+
+type modConfigFile struct {
+	install *cli.Installation
+	disk    disk.Disk
+	path    string
+	format  modConfigFormat
+	exists  bool
+}
+
+func getConfigsDirectory(install *cli.Installation) string {
+	return filepath.Join(install.BasePath(), "FactoryGame", "Configs")
+}
+
+func validateModID(modID string) error {
+	if modID == "" {
+		return fmt.Errorf("mod ID must not be empty")
+	}
+	if strings.ContainsAny(modID, `/\.:`) {
+		return fmt.Errorf("invalid mod ID: %s", modID)
+	}
+	return nil
+}
+
+func findModConfig(modID string) (*modConfigFile, error) {
+	err := validateModID(modID)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return nil, fmt.Errorf("no installation selected")
+	}
+
+	d, err := selectedInstall.GetDisk()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk for installation: %w", err)
+	}
+
+	configsDir := getConfigsDirectory(selectedInstall)
+	for _, ext := range modConfigExtensions {
+		configPath := filepath.Join(configsDir, modID+ext.extension)
+		exists, err := d.Exists(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if config exists: %w", err)
+		}
+		if exists {
+			return &modConfigFile{
+				install: selectedInstall,
+				disk:    d,
+				path:    configPath,
+				format:  ext.format,
+				exists:  true,
+			}, nil
+		}
+	}
+
+	return &modConfigFile{
+		install: selectedInstall,
+		disk:    d,
+		path:    filepath.Join(configsDir, modID+modConfigExtensions[0].extension),
+		format:  modConfigExtensions[0].format,
+		exists:  false,
+	}, nil
+}
+
+func (c *modConfigFile) read() (map[string]interface{}, error) {
+	data, err := c.disk.Read(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return parseModConfig(data, c.format)
+}
+
+func (c *modConfigFile) write(config map[string]interface{}) error {
+	data, err := serializeModConfig(config, c.format)
+	if err != nil {
+		return err
+	}
+	return c.writeRaw(data)
+}
+
+func (c *modConfigFile) writeRaw(data []byte) error {
+	configsDir := filepath.Dir(c.path)
+	exists, err := c.disk.Exists(configsDir)
+	if err != nil {
+		return fmt.Errorf("failed to check if configs directory exists: %w", err)
+	}
+	if !exists {
+		err = c.disk.MkDir(configsDir)
+		if err != nil {
+			return fmt.Errorf("failed to create configs directory: %w", err)
+		}
+	}
+
+	meta := ficsitcli.FicsitCLI.GetInstallationsMetadata()[c.install.Path]
+	if meta.Info != nil && meta.Info.Location == common.LocationTypeLocal {
+		return utils.WriteFileAtomic(c.path, data, 0o644)
+	}
+	// Remote disks have no rename operation, so the file is written in place
+	err = c.disk.Write(c.path, data)
+	if err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+func parseModConfig(data []byte, format modConfigFormat) (map[string]interface{}, error) {
+	switch format {
+	case modConfigFormatJSON:
+		var config map[string]interface{}
+		err := json.Unmarshal(data, &config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JSON config: %w", err)
+		}
+		return config, nil
+	case modConfigFormatINI:
+		iniFile, err := ini.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse INI config: %w", err)
+		}
+		config := make(map[string]interface{})
+		for _, section := range iniFile.Sections() {
+			values := make(map[string]interface{})
+			for _, key := range section.Keys() {
+				values[key.Name()] = key.Value()
+			}
+			if section.Name() == ini.DefaultSection {
+				for k, v := range values {
+					config[k] = v
+				}
+				continue
+			}
+			config[section.Name()] = values
+		}
+		return config, nil
+	}
+	return nil, fmt.Errorf("unknown config format: %s", format)
+}
+
+func serializeModConfig(config map[string]interface{}, format modConfigFormat) ([]byte, error) {
+	switch format {
+	case modConfigFormatJSON:
+		data, err := utils.JSONMarshal(config, 2)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize JSON config: %w", err)
+		}
+		return data, nil
+	case modConfigFormatINI:
+		iniFile := ini.Empty()
+		for key, value := range config {
+			if sectionValues, ok := value.(map[string]interface{}); ok {
+				section, err := iniFile.NewSection(key)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create INI section %s: %w", key, err)
+				}
+				for k, v := range sectionValues {
+					_, err = section.NewKey(k, fmt.Sprint(v))
+					if err != nil {
+						return nil, fmt.Errorf("failed to create INI key %s.%s: %w", key, k, err)
+					}
+				}
+				continue
+			}
+			_, err := iniFile.Section(ini.DefaultSection).NewKey(key, fmt.Sprint(value))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create INI key %s: %w", key, err)
+			}
+		}
+		var sb strings.Builder
+		_, err := iniFile.WriteTo(&sb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize INI config: %w", err)
+		}
+		return []byte(sb.String()), nil
+	}
+	return nil, fmt.Errorf("unknown config format: %s", format)
+}
+
+// validateModConfig checks the new config against the existing one.
+// SML generates the config file from the mod's config class,
+// so the keys and value types already on disk are the mod's schema.
+func validateModConfig(schema, config map[string]interface{}, keyPath string) error {
+	for key, value := range config {
+		fullKey := key
+		if keyPath != "" {
+			fullKey = keyPath + "." + key
+		}
+		schemaValue, ok := schema[key]
+		if !ok {
+			return fmt.Errorf("unknown config key %s", fullKey)
+		}
+		if schemaValue == nil || value == nil {
+			continue
+		}
+		schemaMap, schemaIsMap := schemaValue.(map[string]interface{})
+		valueMap, valueIsMap := value.(map[string]interface{})
+		if schemaIsMap != valueIsMap {
+			return fmt.Errorf("config key %s has the wrong type", fullKey)
+		}
+		if schemaIsMap {
+			err := validateModConfig(schemaMap, valueMap, fullKey)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if fmt.Sprintf("%T", schemaValue) != fmt.Sprintf("%T", value) {
+			return fmt.Errorf("config key %s must be of type %T, got %T", fullKey, schemaValue, value)
+		}
+	}
+	return nil
+}
+
+func (a *app) GetModConfig(modID string) (map[string]interface{}, error) {
+	configFile, err := findModConfig(modID)
+	if err != nil {
+		return nil, err
+	}
+	if !configFile.exists {
+		return nil, fmt.Errorf("%w: %s", ErrModConfigNotFound, modID)
+	}
+	return configFile.read()
+}
+
+func (a *app) SetModConfig(modID string, config map[string]interface{}) error {
+	l := slog.With(slog.String("task", "setModConfig"), slog.String("mod", modID))
+
+	configFile, err := findModConfig(modID)
+	if err != nil {
+		return err
+	}
+
+	if configFile.exists {
+		schema, err := configFile.read()
+		if err != nil {
+			l.Error("failed to read existing config", slog.Any("error", err))
+			return err
+		}
+		err = validateModConfig(schema, config, "")
+		if err != nil {
+			return fmt.Errorf("invalid config for %s: %w", modID, err)
+		}
+	}
+
+	err = configFile.write(config)
+	if err != nil {
+		l.Error("failed to write config", slog.Any("error", err))
+		return err
+	}
+
+	appCommon.EmitEvent(appCommon.AppContext, "modConfigChanged", modID)
+	return nil
+}
+
+var ErrNoDefaultConfig = fmt.Errorf("mod has no bundled default config")
+
+func findDefaultModConfig(archive *zip.Reader, modID string) *zip.File {
+	for _, ext := range modConfigExtensions {
+		for _, dir := range []string{"Configs", "Config"} {
+			for _, file := range archive.File {
+				if file.Name == dir+"/"+modID+ext.extension {
+					return file
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// readDefaultModConfig returns the name and contents of the default config bundled in the mod archive
+func readDefaultModConfig(archivePath, modID string) (string, []byte, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	defaultConfig := findDefaultModConfig(&archive.Reader, modID)
+	if defaultConfig == nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrNoDefaultConfig, modID)
+	}
+
+	reader, err := defaultConfig.Open()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open default config: %w", err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read default config: %w", err)
+	}
+	return defaultConfig.Name, data, nil
+}
+
+func (a *app) ResetModConfig(modID string) error {
+	l := slog.With(slog.String("task", "resetModConfig"), slog.String("mod", modID))
+
+	configFile, err := findModConfig(modID)
+	if err != nil {
+		return err
+	}
+
+	var defaultConfigName string
+	var data []byte
+	err = ficsitcli.WithModArchive(modID, "", func(archivePath string) error {
+		var err error
+		defaultConfigName, data, err = readDefaultModConfig(archivePath, modID)
+		return err
+	})
+	if err != nil {
+		l.Error("failed to read default config", slog.Any("error", err))
+		return err //nolint:wrapcheck
+	}
+
+	ext := path.Ext(defaultConfigName)
+	var defaultFormat modConfigFormat
+	for _, e := range modConfigExtensions {
+		if e.extension == ext {
+			defaultFormat = e.format
+		}
+	}
+	if !configFile.exists || configFile.format != defaultFormat {
+		configFile.path = filepath.Join(filepath.Dir(configFile.path), modID+ext)
+		configFile.format = defaultFormat
+	}
+
+	// Never replace the active config with something the mod could not load
+	_, err = parseModConfig(data, configFile.format)
+	if err != nil {
+		return fmt.Errorf("bundled default config is invalid: %w", err)
+	}
+
+	err = configFile.writeRaw(data)
+	if err != nil {
+		l.Error("failed to write config", slog.Any("error", err))
+		return err
+	}
+
+	appCommon.EmitEvent(appCommon.AppContext, "modConfigReset", modID)
+	return nil
+}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+// GetNetworkUsageSinceStart returns the network usage of the backend's requests since the app started.
+// The frontend's requests go through the webview, so they are not included.
+func (a *app) GetNetworkUsageSinceStart() (utils.NetworkUsage, error) {
+	return utils.GetNetworkUsage(), nil
+}
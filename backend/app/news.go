@@ -0,0 +1,254 @@
+package app
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type NewsItem struct {
+	Title       string    `json:"title"`
+	Summary     string    `json:"summary"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+const maxNewsItems = 10
+
+// Keyed by feed URL, so changing the feed in the settings does not return the old feed
+var newsFeedCache = utils.NewTTLCache[string, []NewsItem](1 * time.Hour)
+
+var (
+	lastNewsFeed   []NewsItem
+	lastNewsFeedMu sync.Mutex
+)
+
+type rssFeed struct {
+	Channel struct {
+		Items []struct {
+			Title       string `xml:"title"`
+			Link        string `xml:"link"`
+			Description string `xml:"description"`
+			PubDate     string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atomFeed struct {
+	Entries []struct {
+		Title   string `xml:"title"`
+		Summary string `xml:"summary"`
+		Content string `xml:"content"`
+		Links   []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+	} `xml:"entry"`
+}
+
+var (
+	unsafeElementsRegex = regexp.MustCompile(`(?is)<(script|style|iframe|object|embed)\b.*?</(script|style|iframe|object|embed)\s*>`)
+	htmlTagRegex        = regexp.MustCompile(`(?s)<[^>]*>`)
+	whitespaceRegex     = regexp.MustCompile(`\s+`)
+)
+
+// stripHTML reduces a feed summary to plain text, so the frontend never renders markup from the feed.
+// Entities are unescaped first, so escaped markup is stripped too instead of turning into tags.
+func stripHTML(s string) string {
+	s = html.UnescapeString(s)
+	s = unsafeElementsRegex.ReplaceAllString(s, " ")
+	s = htmlTagRegex.ReplaceAllString(s, " ")
+	return strings.TrimSpace(whitespaceRegex.ReplaceAllString(s, " "))
+}
+
+// isWebURL only allows links the frontend can safely open, not javascript: or file: URLs
+func isWebURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+func parseFeedTime(value string) time.Time {
+	for _, layout := range []string{time.RFC3339, time.RFC1123Z, time.RFC1123} {
+		if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func parseNewsFeed(data []byte) ([]NewsItem, error) {
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse feed: %w", err)
+	}
+
+	items := make([]NewsItem, 0)
+	switch root.XMLName.Local {
+	case "rss":
+		var feed rssFeed
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
+		}
+		for _, item := range feed.Channel.Items {
+			items = append(items, NewsItem{
+				Title:       strings.TrimSpace(item.Title),
+				Summary:     stripHTML(item.Description),
+				URL:         strings.TrimSpace(item.Link),
+				PublishedAt: parseFeedTime(item.PubDate),
+			})
+		}
+	case "feed":
+		var feed atomFeed
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return nil, fmt.Errorf("failed to parse Atom feed: %w", err)
+		}
+		for _, entry := range feed.Entries {
+			item := NewsItem{
+				Title:       strings.TrimSpace(entry.Title),
+				Summary:     stripHTML(entry.Summary),
+				PublishedAt: parseFeedTime(entry.Published),
+			}
+			if item.Summary == "" {
+				item.Summary = stripHTML(entry.Content)
+			}
+			if item.PublishedAt.IsZero() {
+				item.PublishedAt = parseFeedTime(entry.Updated)
+			}
+			for _, link := range entry.Links {
+				if link.Rel == "" || link.Rel == "alternate" {
+					item.URL = link.Href
+					break
+				}
+			}
+			items = append(items, item)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported feed format: %s", root.XMLName.Local)
+	}
+
+	items = slices.DeleteFunc(items, func(item NewsItem) bool {
+		return !isWebURL(item.URL)
+	})
+	slices.SortStableFunc(items, func(a, b NewsItem) int {
+		return b.PublishedAt.Compare(a.PublishedAt)
+	})
+	if len(items) > maxNewsItems {
+		items = items[:maxNewsItems]
+	}
+	return items, nil
+}
+
+func fetchNewsFeed(feedURL string) ([]NewsItem, error) {
+	response, err := http.Get(feedURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch news feed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("news feed returned status %s", response.Status)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read news feed: %w", err)
+	}
+	return parseNewsFeed(data)
+}
+
+func getNewsFeedURL() string {
+	if settings.Settings.NewsFeedURL != "" {
+		return settings.Settings.NewsFeedURL
+	}
+	return viper.GetString("news-feed-url")
+}
+
+// GetFicsitNewsFeed returns the latest items of the news feed, or none in offline mode
+func (a *app) GetFicsitNewsFeed() ([]NewsItem, error) {
+	if settings.Settings.Offline {
+		return []NewsItem{}, nil
+	}
+	feedURL := getNewsFeedURL()
+	items, err := newsFeedCache.GetOrCompute(feedURL, func() ([]NewsItem, error) {
+		return fetchNewsFeed(feedURL)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastNewsFeedMu.Lock()
+	changed := !slices.Equal(lastNewsFeed, items)
+	lastNewsFeed = items
+	lastNewsFeedMu.Unlock()
+	if changed {
+		common.EmitEvent(common.AppContext, "newsFeedUpdated", items)
+	}
+
+	return slices.Clone(items), nil
+}
+
+// Only the most recently read items are remembered, older ones are no longer in the feed anyway
+const maxReadNewsURLs = 100
+
+func countUnreadNews(items []NewsItem) int {
+	unread := 0
+	for _, item := range items {
+		if !slices.Contains(settings.Settings.ReadNewsURLs, item.URL) {
+			unread++
+		}
+	}
+	return unread
+}
+
+func (a *app) MarkNewsItemRead(url string) error {
+	if url == "" {
+		return fmt.Errorf("news item URL cannot be empty")
+	}
+	if slices.Contains(settings.Settings.ReadNewsURLs, url) {
+		return nil
+	}
+	previousUnread := a.GetUnreadNewsCount()
+
+	settings.Settings.ReadNewsURLs = append(settings.Settings.ReadNewsURLs, url)
+	if len(settings.Settings.ReadNewsURLs) > maxReadNewsURLs {
+		settings.Settings.ReadNewsURLs = slices.Delete(settings.Settings.ReadNewsURLs, 0, len(settings.Settings.ReadNewsURLs)-maxReadNewsURLs)
+	}
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save read news: %w", err)
+	}
+
+	unread := a.GetUnreadNewsCount()
+	if unread != previousUnread {
+		common.EmitEvent(common.AppContext, "unreadNewsCountChanged", unread)
+	}
+	return nil
+}
+
+func (a *app) GetUnreadNewsCount() int {
+	items, err := a.GetFicsitNewsFeed()
+	if err != nil {
+		slog.Warn("failed to get news feed", slog.Any("error", err))
+		return 0
+	}
+	return countUnreadNews(items)
+}
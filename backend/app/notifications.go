@@ -0,0 +1,18 @@
+package app
+
+import (
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/notifications"
+)
+
+func (a *app) GetNotificationHistory() ([]notifications.AppNotification, error) {
+	return notifications.History(), nil
+}
+
+func (a *app) DismissNotification(id string) error {
+	return notifications.Dismiss(id)
+}
+
+func (a *app) DismissAllNotifications() error {
+	notifications.DismissAll()
+	return nil
+}
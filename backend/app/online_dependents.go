@@ -0,0 +1,131 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const getModsLatestDependenciesQuery = `query GetModsLatestDependencies($filter: ModFilter) {
+  getMods(filter: $filter) {
+    count
+    mods {` + ficsitModFields + `
+      latestVersions {
+        alpha {
+          dependencies {
+            mod_id
+          }
+        }
+        beta {
+          dependencies {
+            mod_id
+          }
+        }
+        release {
+          dependencies {
+            mod_id
+          }
+        }
+      }
+    }
+  }
+}`
+
+type ficsitVersionDependencies struct {
+	Dependencies []struct {
+		ModID string `json:"mod_id"`
+	} `json:"dependencies"`
+}
+
+// Keyed by the mod_id of the dependency, the mods whose latest versions depend on it
+var onlineDependentsCache = utils.NewTTLCache[string, map[string][]ficsitMod](1 * time.Hour)
+
+// getOnlineDependents builds the reverse dependency index of every mod on ficsit.app.
+// ficsit.app cannot filter mods by dependency, so all the mods have to be fetched.
+func getOnlineDependents() (map[string][]ficsitMod, error) {
+	return onlineDependentsCache.GetOrCompute("", func() (map[string][]ficsitMod, error) {
+		dependents := make(map[string][]ficsitMod)
+		for offset := 0; ; offset += ficsitAPIMaxLimit {
+			var response struct {
+				GetMods struct {
+					Count int `json:"count"`
+					Mods  []struct {
+						ficsitMod
+						LatestVersions struct {
+							Alpha   *ficsitVersionDependencies `json:"alpha"`
+							Beta    *ficsitVersionDependencies `json:"beta"`
+							Release *ficsitVersionDependencies `json:"release"`
+						} `json:"latestVersions"`
+					} `json:"mods"`
+				} `json:"getMods"`
+			}
+			err := queryFicsitAPI(getModsLatestDependenciesQuery, map[string]interface{}{
+				"filter": map[string]interface{}{
+					"limit":  ficsitAPIMaxLimit,
+					"offset": offset,
+				},
+			}, &response)
+			if err != nil {
+				return nil, err
+			}
+			for _, mod := range response.GetMods.Mods {
+				modDataCache.Set(mod.ModReference, mod.ficsitMod)
+				dependencies := make(map[string]bool)
+				for _, version := range []*ficsitVersionDependencies{mod.LatestVersions.Alpha, mod.LatestVersions.Beta, mod.LatestVersions.Release} {
+					if version == nil {
+						continue
+					}
+					for _, dependency := range version.Dependencies {
+						dependencies[dependency.ModID] = true
+					}
+				}
+				for dependency := range dependencies {
+					dependents[dependency] = append(dependents[dependency], mod.ficsitMod)
+				}
+			}
+			if len(response.GetMods.Mods) < ficsitAPIMaxLimit || offset+ficsitAPIMaxLimit >= response.GetMods.Count {
+				break
+			}
+		}
+		return dependents, nil
+	})
+}
+
+// GetModsByDependency returns the mods on ficsit.app whose latest versions depend on the mod, most downloaded first.
+// Unlike GetModDependents, this is not limited to the installed mods.
+func (a *app) GetModsByDependency(dependencyModID string) ([]ModSummary, error) {
+	if dependencyModID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	modsData, err := getModsData([]string{dependencyModID})
+	if err != nil {
+		return nil, err
+	}
+	dependency, ok := modsData[dependencyModID]
+	if !ok {
+		return nil, fmt.Errorf("mod %s not found", dependencyModID)
+	}
+	dependents, err := getOnlineDependents()
+	if err != nil {
+		return nil, err
+	}
+
+	// Dependencies are listed by mod reference, but the internal ID is checked too
+	mods := slices.Clone(dependents[dependency.ModReference])
+	if dependency.ID != dependency.ModReference {
+		mods = append(mods, dependents[dependency.ID]...)
+	}
+	slices.SortFunc(mods, func(a, b ficsitMod) int {
+		if c := cmp.Compare(b.Downloads, a.Downloads); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ModReference, b.ModReference)
+	})
+	mods = slices.CompactFunc(mods, func(a, b ficsitMod) bool {
+		return a.ModReference == b.ModReference
+	})
+	return withUserState(toModSummaries(mods), true), nil
+}
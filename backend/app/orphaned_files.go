@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+)
+
+type OrphanedFile struct {
+	Path         string    `json:"path"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+func getLocalModsDirectory() (string, error) {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return "", ErrGameDirectoryNotFound
+	}
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info == nil || meta.Info.Location != common.LocationTypeLocal {
+		return "", fmt.Errorf("cannot read the mods directory of a remote installation")
+	}
+	return filepath.Join(selectedInstall.Path, "FactoryGame", "Mods"), nil
+}
+
+// trackedMods are the mods installed in the selected installation, or part of any profile
+func trackedMods() (map[string]bool, error) {
+	tracked := make(map[string]bool)
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	for modID := range lockfileMods {
+		tracked[modID] = true
+	}
+	for _, profileName := range ficsitcli.FicsitCLI.GetProfiles() {
+		profile := ficsitcli.FicsitCLI.GetProfile(profileName)
+		if profile == nil {
+			continue
+		}
+		for modID := range profile.Mods {
+			tracked[modID] = true
+		}
+	}
+	return tracked, nil
+}
+
+// orphanedFileInfo sums up the size of everything under the path, and uses the latest modification time found
+func orphanedFileInfo(path string) (OrphanedFile, error) {
+	orphan := OrphanedFile{Path: path}
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		if !entry.IsDir() {
+			orphan.Size += info.Size()
+		}
+		if info.ModTime().After(orphan.LastModified) {
+			orphan.LastModified = info.ModTime()
+		}
+		return nil
+	})
+	if err != nil {
+		return OrphanedFile{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return orphan, nil
+}
+
+// GetOrphanedModFiles lists the files and directories in the mods directory of the selected installation
+// that do not belong to any mod in its lockfile or in any profile, such as manually installed mods.
+// Directories are listed as a single entry.
+func (a *app) GetOrphanedModFiles() ([]OrphanedFile, error) {
+	modsDir, err := getLocalModsDirectory()
+	if err != nil {
+		return nil, err
+	}
+	tracked, err := trackedMods()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(modsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []OrphanedFile{}, nil
+		}
+		return nil, fmt.Errorf("failed to read mods directory: %w", err)
+	}
+
+	orphans := make([]OrphanedFile, 0)
+	for _, entry := range entries {
+		if entry.IsDir() && tracked[entry.Name()] {
+			continue
+		}
+		orphan, err := orphanedFileInfo(filepath.Join(modsDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		orphans = append(orphans, orphan)
+	}
+	return orphans, nil
+}
+
+// DeleteOrphanedFiles removes the given paths, which must all be returned by GetOrphanedModFiles.
+// Nothing is deleted if any of them is not.
+func (a *app) DeleteOrphanedFiles(paths []string) error {
+	if ficsitcli.FicsitCLI.IsGameRunning() {
+		return fmt.Errorf("cannot delete mod files while the game is running")
+	}
+	orphans, err := a.GetOrphanedModFiles()
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if !slices.ContainsFunc(orphans, func(orphan OrphanedFile) bool { return orphan.Path == filepath.Clean(path) }) {
+			return fmt.Errorf("%s is not an orphaned mod file", path)
+		}
+	}
+	for _, path := range paths {
+		err := os.RemoveAll(filepath.Clean(path))
+		if err != nil {
+			return fmt.Errorf("failed to delete %s: %w", path, err)
+		}
+	}
+	return nil
+}
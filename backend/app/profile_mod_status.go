@@ -0,0 +1,99 @@
+package app
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+// The version constraint of mods added to a profile without picking a version
+const anyVersionConstraint = ">=0.0.0"
+
+var (
+	profileModStatusMu sync.Mutex
+	// The mods with an update available from the last update check, and the installation and profile it was for
+	modUpdatesAvailable        = map[string]bool{}
+	modUpdatesAvailableProfile string
+	lastProfileModStatus       map[string]int
+)
+
+func selectedInstallProfileKey() string {
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return ""
+	}
+	return selectedInstall.Path + "|" + selectedInstall.Profile
+}
+
+func setModUpdatesAvailable(updates []ModUpdateSummary) {
+	profileModStatusMu.Lock()
+	modUpdatesAvailable = make(map[string]bool, len(updates))
+	for _, update := range updates {
+		modUpdatesAvailable[update.ModID] = true
+	}
+	modUpdatesAvailableProfile = selectedInstallProfileKey()
+	profileModStatusMu.Unlock()
+
+	refreshProfileModStatus()
+}
+
+// profileModCountByStatus only uses what is already in memory, so mods that were not checked yet
+// are not counted as having an update or being incompatible
+func profileModCountByStatus() map[string]int {
+	counts := map[string]int{
+		"enabled":         0,
+		"disabled":        0,
+		"updateAvailable": 0,
+		"pinned":          0,
+		"incompatible":    0,
+	}
+	updatesKnown := modUpdatesAvailableProfile != "" && modUpdatesAvailableProfile == selectedInstallProfileKey()
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	for modID, mod := range ficsitcli.FicsitCLI.GetSelectedInstallProfileMods() {
+		if mod.Enabled {
+			counts["enabled"]++
+		} else {
+			counts["disabled"]++
+		}
+		if mod.Version != anyVersionConstraint {
+			counts["pinned"]++
+		}
+		if updatesKnown && modUpdatesAvailable[modID] {
+			counts["updateAvailable"]++
+		}
+		// Broken is the only state ficsit.app reports for mods that do not work on a branch
+		if data, ok := modCompatibilityDataCache.Get(modID); ok && meta.Info != nil {
+			if factor, ok := reportedCompatibilityFactor(data, meta.Info.Branch); ok && factor.Points == 0 {
+				counts["incompatible"]++
+			}
+		}
+	}
+	return counts
+}
+
+// refreshProfileModStatus emits profileModStatusChanged if the counts changed since they were last computed
+func refreshProfileModStatus() map[string]int {
+	profileModStatusMu.Lock()
+	defer profileModStatusMu.Unlock()
+	counts := profileModCountByStatus()
+	if !maps.Equal(counts, lastProfileModStatus) {
+		lastProfileModStatus = counts
+		common.EmitEvent(common.AppContext, "profileModStatusChanged", counts)
+	}
+	return maps.Clone(counts)
+}
+
+// GetProfileModCountByStatus counts the mods of the selected profile by status, without any file or network access.
+// Update availability comes from the last GetModsNeedingUpdate call, and incompatibility from the cached ficsit.app compatibility reports.
+func (a *app) GetProfileModCountByStatus() (map[string]int, error) {
+	return refreshProfileModStatus(), nil
+}
+
+// WatchProfileModStatus emits profileModStatusChanged when the mods of the selected profile change
+func (a *app) WatchProfileModStatus() {
+	ficsitcli.FicsitCLI.ModsChanged.On(func(interface{}) {
+		refreshProfileModStatus()
+	})
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+var ErrProfileCreationDateUnknown = fmt.Errorf("profile creation date is unknown")
+
+// GetProfileCreationDate returns when the profile was created in SMM.
+// Profiles created before this was tracked, or by other ficsit-cli clients, have no known creation date.
+func (a *app) GetProfileCreationDate(profileName string) (time.Time, error) {
+	if ficsitcli.FicsitCLI.GetProfile(profileName) == nil {
+		return time.Time{}, fmt.Errorf("profile %s does not exist", profileName)
+	}
+	createdAt, ok := settings.Settings.ProfileCreationDates[profileName]
+	if !ok {
+		return time.Time{}, ErrProfileCreationDateUnknown
+	}
+	return createdAt, nil
+}
+
+// ModMatrix is a table of which profiles each mod is in, Installed[i][j] is whether ModIDs[i] is in ProfileNames[j]
+type ModMatrix struct {
+	ModIDs       []string `json:"modIds"`
+	ProfileNames []string `json:"profileNames"`
+	Installed    [][]bool `json:"installed"`
+}
+
+// GetProfileModMatrix lists every mod in any profile, along with the profiles it is in.
+// Profiles are deleted immediately rather than trashed, so every existing profile is included.
+func (a *app) GetProfileModMatrix() (ModMatrix, error) {
+	profileNames := ficsitcli.FicsitCLI.GetProfiles()
+
+	profileMods := make([]map[string]bool, 0, len(profileNames))
+	modIDs := make([]string, 0)
+	for _, profileName := range profileNames {
+		profile := ficsitcli.FicsitCLI.GetProfile(profileName)
+		if profile == nil {
+			return ModMatrix{}, fmt.Errorf("profile %s does not exist", profileName)
+		}
+		mods := make(map[string]bool, len(profile.Mods))
+		for modID := range profile.Mods {
+			mods[modID] = true
+			modIDs = append(modIDs, modID)
+		}
+		profileMods = append(profileMods, mods)
+	}
+	slices.Sort(modIDs)
+	modIDs = slices.Compact(modIDs)
+
+	installed := make([][]bool, 0, len(modIDs))
+	for _, modID := range modIDs {
+		row := make([]bool, len(profileNames))
+		for i, mods := range profileMods {
+			row[i] = mods[modID]
+		}
+		installed = append(installed, row)
+	}
+
+	return ModMatrix{
+		ModIDs:       modIDs,
+		ProfileNames: profileNames,
+		Installed:    installed,
+	}, nil
+}
+
+type ProfileSize struct {
+	TotalBytes int64 `json:"totalBytes"`
+	// SharedMods are the mods whose installed version is also installed by another profile
+	SharedMods []string `json:"sharedMods"`
+}
+
+// GetProfileSize returns the disk size of the mods installed for a profile, counting each mod version once
+// even if the profile is used by multiple installations. Mods of the profile that were never installed are not counted.
+func (a *app) GetProfileSize(profileName string) (ProfileSize, error) {
+	if ficsitcli.FicsitCLI.GetProfile(profileName) == nil {
+		return ProfileSize{}, fmt.Errorf("profile %s does not exist", profileName)
+	}
+
+	// Each target has its own archive, so the same mod version is counted once per target
+	type installedModArchive struct {
+		modID      string
+		version    string
+		targetName string
+	}
+	profileMods := make(map[installedModArchive]bool)
+	otherMods := make(map[installedModArchive]bool)
+	for _, installPath := range ficsitcli.FicsitCLI.GetInstallations() {
+		lockfile, err := ficsitcli.FicsitCLI.GetInstallationLockfile(installPath)
+		if err != nil {
+			slog.Warn("failed to get lockfile, skipping installation", slog.String("install", installPath), slog.Any("error", err))
+			continue
+		}
+		if lockfile == nil {
+			continue
+		}
+		targetName, err := ficsitcli.FicsitCLI.GetInstallationTargetName(installPath)
+		if err != nil {
+			slog.Warn("failed to get target, skipping installation", slog.String("install", installPath), slog.Any("error", err))
+			continue
+		}
+		mods := otherMods
+		if ficsitcli.FicsitCLI.GetInstallation(installPath).Profile == profileName {
+			mods = profileMods
+		}
+		for modID, lockedMod := range lockfile.Mods {
+			mods[installedModArchive{modID, lockedMod.Version, targetName}] = true
+		}
+	}
+
+	size := ProfileSize{
+		SharedMods: make([]string, 0),
+	}
+	for mod := range profileMods {
+		archiveInfo, err := ficsitcli.FicsitCLI.GetModArchiveInfoForTarget(mod.modID, mod.version, mod.targetName)
+		if err != nil {
+			return ProfileSize{}, fmt.Errorf("failed to get archive info of %s %s: %w", mod.modID, mod.version, err)
+		}
+		info, err := modInstallSize(archiveInfo)
+		if err != nil {
+			return ProfileSize{}, fmt.Errorf("failed to get size of %s %s: %w", mod.modID, mod.version, err)
+		}
+		size.TotalBytes += info.ExtractedBytes
+		if otherMods[mod] && !slices.Contains(size.SharedMods, mod.modID) {
+			size.SharedMods = append(size.SharedMods, mod.modID)
+		}
+	}
+	slices.Sort(size.SharedMods)
+	return size, nil
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+type ProxyConfig struct {
+	DefaultProxy string `json:"defaultProxy"`
+	// PerDomainProxies are keyed by domain, which also matches its subdomains
+	PerDomainProxies map[string]string `json:"perDomainProxies"`
+	NoProxyList      []string          `json:"noProxyList"`
+}
+
+var proxySchemes = []string{"http", "https", "socks5"}
+
+func validateProxyURL(proxy string) error {
+	parsed, err := url.Parse(proxy)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL %s: %w", proxy, err)
+	}
+	if !slices.Contains(proxySchemes, parsed.Scheme) || parsed.Host == "" {
+		return fmt.Errorf("invalid proxy URL %s, expected scheme://host:port with http, https or socks5", proxy)
+	}
+	return nil
+}
+
+func validateProxyDomain(domain string) error {
+	if domain == "" || strings.ContainsAny(domain, "/: ") {
+		return fmt.Errorf("invalid domain %q", domain)
+	}
+	return nil
+}
+
+func (a *app) GetProxyConfig() (ProxyConfig, error) {
+	config := ProxyConfig{
+		DefaultProxy:     settings.Settings.Proxy,
+		PerDomainProxies: maps.Clone(settings.Settings.PerDomainProxies),
+		NoProxyList:      slices.Clone(settings.Settings.NoProxyList),
+	}
+	if config.PerDomainProxies == nil {
+		config.PerDomainProxies = map[string]string{}
+	}
+	if config.NoProxyList == nil {
+		config.NoProxyList = []string{}
+	}
+	return config, nil
+}
+
+// SetProxyConfig saves the proxy settings. The per-domain proxies and the no proxy list apply immediately,
+// the default proxy is also used by the frontend, so it only applies there after a restart.
+func (a *app) SetProxyConfig(cfg ProxyConfig) error {
+	if cfg.DefaultProxy != "" {
+		if err := validateProxyURL(cfg.DefaultProxy); err != nil {
+			return err
+		}
+	}
+	for domain, proxy := range cfg.PerDomainProxies {
+		if err := validateProxyDomain(domain); err != nil {
+			return err
+		}
+		if err := validateProxyURL(proxy); err != nil {
+			return err
+		}
+	}
+	for _, domain := range cfg.NoProxyList {
+		if err := validateProxyDomain(domain); err != nil {
+			return err
+		}
+	}
+
+	settings.Settings.Proxy = cfg.DefaultProxy
+	settings.Settings.PerDomainProxies = maps.Clone(cfg.PerDomainProxies)
+	settings.Settings.NoProxyList = slices.Clone(cfg.NoProxyList)
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save proxy config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+const maxQuickLaunchProfiles = 5
+
+// GetQuickLaunchProfiles returns the pinned profiles in order, skipping the ones that were deleted since
+func (a *app) GetQuickLaunchProfiles() ([]string, error) {
+	profiles := make([]string, 0, len(settings.Settings.QuickLaunchProfiles))
+	for _, name := range settings.Settings.QuickLaunchProfiles {
+		if ficsitcli.FicsitCLI.GetProfile(name) != nil {
+			profiles = append(profiles, name)
+		}
+	}
+	return profiles, nil
+}
+
+func (a *app) SetQuickLaunchProfiles(profileNames []string) error {
+	if len(profileNames) > maxQuickLaunchProfiles {
+		return fmt.Errorf("at most %d quick launch profiles can be set", maxQuickLaunchProfiles)
+	}
+	profiles := make([]string, 0, len(profileNames))
+	for _, name := range profileNames {
+		if ficsitcli.FicsitCLI.GetProfile(name) == nil {
+			return fmt.Errorf("profile %s does not exist", name)
+		}
+		if !slices.Contains(profiles, name) {
+			profiles = append(profiles, name)
+		}
+	}
+
+	settings.Settings.QuickLaunchProfiles = profiles
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save quick launch profiles: %w", err)
+	}
+	common.EmitEvent(common.AppContext, "quickLaunchProfilesChanged", profiles)
+	return nil
+}
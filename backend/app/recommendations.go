@@ -0,0 +1,121 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const (
+	recommendationsCount = 10
+	// The most popular mods are the candidates, since ficsit.app cannot search by several tags at once
+	recommendationCandidatePages = 3
+)
+
+// Keyed by the hash of the sorted installed mod list
+var modRecommendationsCache = utils.NewTTLCache[string, []ModSummary](1 * time.Hour)
+
+func modListHash(modIDs []string) string {
+	sorted := slices.Clone(modIDs)
+	slices.Sort(sorted)
+	hash := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+func getModRecommendations(installedModIDs []string) ([]ModSummary, error) {
+	installed := make(map[string]bool, len(installedModIDs))
+	for _, modID := range installedModIDs {
+		installed[modID] = true
+	}
+	installedData, err := getModsData(installedModIDs)
+	if err != nil {
+		return nil, err
+	}
+	// How many of the installed mods have each tag, and are made by each author
+	tagWeights := make(map[string]int)
+	authorWeights := make(map[string]int)
+	for _, mod := range installedData {
+		for _, tag := range mod.Tags {
+			tagWeights[tag.ID]++
+		}
+		for _, author := range mod.Authors {
+			authorWeights[author.User.ID]++
+		}
+	}
+
+	type candidate struct {
+		mod   ficsitMod
+		score int
+	}
+	candidates := make([]candidate, 0, recommendationCandidatePages*ficsitAPIMaxLimit)
+	for page := 0; page < recommendationCandidatePages; page++ {
+		mods, _, err := queryMods(map[string]interface{}{
+			"order_by": "popularity",
+			"order":    "desc",
+			"limit":    ficsitAPIMaxLimit,
+			"offset":   page * ficsitAPIMaxLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range mods {
+			if installed[mod.ModReference] {
+				continue
+			}
+			score := 0
+			for _, tag := range mod.Tags {
+				score += tagWeights[tag.ID]
+			}
+			for _, author := range mod.Authors {
+				score += authorWeights[author.User.ID]
+			}
+			if score > 0 {
+				candidates = append(candidates, candidate{mod, score})
+			}
+		}
+		if len(mods) < ficsitAPIMaxLimit {
+			break
+		}
+	}
+
+	// The candidates are in popularity order, so the stable sort prefers the more popular mods for ties
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return b.score - a.score
+	})
+	recommendations := make([]ModSummary, 0, len(candidates))
+	for _, c := range candidates {
+		recommendations = append(recommendations, toModSummary(c.mod))
+	}
+	return recommendations, nil
+}
+
+// GetModRecommendations returns popular mods that are not installed, ranked by how many tags and authors they share with the installed mods.
+// ficsit.app has no download correlation data, so this is as close to "users who have X also have Y" as it gets.
+// If no mods are given, the mods installed in the selected installation are used.
+func (a *app) GetModRecommendations(installedModIDs []string) ([]ModSummary, error) {
+	if len(installedModIDs) == 0 {
+		mods, err := getInstalledMods()
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range mods {
+			installedModIDs = append(installedModIDs, mod.ModID)
+		}
+	}
+	recommendations, err := modRecommendationsCache.GetOrCompute(modListHash(installedModIDs), func() ([]ModSummary, error) {
+		return getModRecommendations(installedModIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Hidden mods are filtered after the cache, since the user can change them
+	recommendations = withUserState(recommendations, false)
+	if len(recommendations) > recommendationsCount {
+		recommendations = recommendations[:recommendationsCount]
+	}
+	return recommendations, nil
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const relatedModsCount = 5
+
+// Candidates for the related mods, before the installed and hidden mods are removed
+var relatedModsCache = utils.NewTTLCache[string, []ModSummary](1 * time.Hour)
+
+func getRelatedModCandidates(modID string) ([]ModSummary, error) {
+	modsData, err := getModsData([]string{modID})
+	if err != nil {
+		return nil, err
+	}
+	mod, ok := modsData[modID]
+	if !ok {
+		return nil, fmt.Errorf("mod %s not found", modID)
+	}
+
+	candidates := make(map[string]ModSummary)
+	for _, author := range mod.Authors {
+		// GetModsByAuthor is cached, and an author rarely has more mods than fit on a page
+		result, err := App.GetModsByAuthor(author.User.ID, 0, ficsitAPIMaxLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mods by %s: %w", author.User.Username, err)
+		}
+		for _, summary := range result.Mods {
+			candidates[summary.ModID] = summary
+		}
+	}
+	if len(mod.Tags) > 0 {
+		// Only the most downloaded mods of the tags can make it into the result anyway
+		tagMods, _, err := queryMods(map[string]interface{}{
+			"tagIDs":   lo.Map(mod.Tags, func(tag ficsitTag, _ int) string { return tag.ID }),
+			"order_by": "downloads",
+			"order":    "desc",
+			"limit":    ficsitAPIMaxLimit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mods with similar tags: %w", err)
+		}
+		for _, tagMod := range tagMods {
+			candidates[tagMod.ModReference] = toModSummary(tagMod)
+		}
+	}
+	delete(candidates, modID)
+
+	result := make([]ModSummary, 0, len(candidates))
+	for _, summary := range candidates {
+		result = append(result, summary)
+	}
+	slices.SortFunc(result, func(a, b ModSummary) int {
+		if c := cmp.Compare(b.Downloads, a.Downloads); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ModID, b.ModID)
+	})
+	return result, nil
+}
+
+// GetModRelatedMods returns the most downloaded mods that share an author or a tag with the mod, leaving out the installed and hidden mods
+func (a *app) GetModRelatedMods(modID string) ([]ModSummary, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	candidates, err := relatedModsCache.GetOrCompute(modID, func() ([]ModSummary, error) {
+		return getRelatedModCandidates(modID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The installed and hidden mods are filtered after the cache, since they can change
+	installedMods, err := getInstalledMods()
+	if err != nil {
+		return nil, err
+	}
+	installed := make(map[string]bool, len(installedMods))
+	for _, mod := range installedMods {
+		installed[mod.ModID] = true
+	}
+	related := make([]ModSummary, 0, relatedModsCount)
+	for _, summary := range withUserState(candidates, false) {
+		if installed[summary.ModID] {
+			continue
+		}
+		related = append(related, summary)
+		if len(related) == relatedModsCount {
+			break
+		}
+	}
+	return related, nil
+}
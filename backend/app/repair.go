@@ -0,0 +1,95 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type RepairStep struct {
+	Check   string `json:"check"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+var ErrNoAutomaticRepair = fmt.Errorf("this issue cannot be repaired automatically")
+
+const repairReinstallMods = "reinstallMods"
+
+// Reinstalling removes the broken mods, SML included, so applying the profile extracts them again
+var healthCheckRepairs = map[string]string{
+	healthCheckModFiles: repairReinstallMods,
+	healthCheckSML:      repairReinstallMods,
+}
+
+func runRepair(repair string) error {
+	if repair != repairReinstallMods {
+		return ErrNoAutomaticRepair
+	}
+	selectedInstall := ficsitcli.FicsitCLI.GetSelectedInstall()
+	if selectedInstall == nil {
+		return ErrGameDirectoryNotFound
+	}
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return fmt.Errorf("failed to get lockfile: %w", err)
+	}
+	brokenMods, err := modsMissingPlugin(selectedInstall, lockfileMods)
+	if err != nil {
+		return err
+	}
+	err = ficsitcli.FicsitCLI.ReinstallMods(brokenMods)
+	if err != nil {
+		return fmt.Errorf("failed to reinstall mods: %w", err)
+	}
+	return nil
+}
+
+// RepairInstallation runs the repair of every failed health check, then checks the installation again.
+// A failed repair does not stop the others, the errors of all failed repairs are returned together.
+func (a *app) RepairInstallation() error {
+	report, err := a.CheckInstallHealth()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	// Checks that share a repair only run it once
+	repairResults := make(map[string]error)
+	for _, check := range report.Checks {
+		if check.OK {
+			continue
+		}
+		step := RepairStep{Check: check.Name}
+		repair, ok := healthCheckRepairs[check.Name]
+		if !ok {
+			step.Error = ErrNoAutomaticRepair.Error()
+			common.EmitEvent(common.AppContext, "repairProgress", step)
+			continue
+		}
+		repairErr, done := repairResults[repair]
+		if !done {
+			repairErr = runRepair(repair)
+			repairResults[repair] = repairErr
+			if repairErr != nil {
+				slog.Error("failed to repair installation", slog.String("check", check.Name), slog.Any("error", repairErr))
+				errs = append(errs, fmt.Errorf("failed to repair %s: %w", check.Name, repairErr))
+			}
+		}
+		step.Success = repairErr == nil
+		if repairErr != nil {
+			step.Error = repairErr.Error()
+		}
+		common.EmitEvent(common.AppContext, "repairProgress", step)
+	}
+
+	report, err = a.CheckInstallHealth()
+	if err != nil {
+		return err
+	}
+	common.EmitEvent(common.AppContext, "repairCompleted", report)
+	return errors.Join(errs...)
+}
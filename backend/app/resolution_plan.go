@@ -0,0 +1,122 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	resolver "github.com/satisfactorymodding/ficsit-resolver"
+	"golang.org/x/exp/maps"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type ModVersionChange struct {
+	ModID       string `json:"modId"`
+	FromVersion string `json:"fromVersion"`
+	ToVersion   string `json:"toVersion"`
+	Reason      string `json:"reason"`
+}
+
+type ConflictInfo struct {
+	// ModIDs are the mods involved in the conflict, FactoryGame is the game itself
+	ModIDs  []string `json:"modIds"`
+	Message string   `json:"message"`
+}
+
+type ResolutionPlan struct {
+	Install   []ModVersionChange `json:"install"`
+	Update    []ModVersionChange `json:"update"`
+	NoChange  []ModVersionChange `json:"noChange"`
+	Conflicts []ConflictInfo     `json:"conflicts"`
+}
+
+// changeReason explains why the mod is part of the new lockfile
+func changeReason(modID string, requested map[string]string, dependencies map[string]map[string]ModVersionConstraint) string {
+	if _, ok := requested[modID]; ok {
+		return "Requested"
+	}
+	dependents := make([]string, 0)
+	for otherModID, modDependencies := range dependencies {
+		if dependency, ok := modDependencies[modID]; ok && !dependency.Optional {
+			dependents = append(dependents, otherModID)
+		}
+	}
+	if len(dependents) > 0 {
+		slices.Sort(dependents)
+		return "Dependency of " + strings.Join(dependents, ", ")
+	}
+	return "In profile"
+}
+
+// GetDependencyResolutionPlan shows what installing the given mods into the selected profile would change, without installing anything.
+// Mods without a version in targetVersions use the latest compatible one. Mods only in targetVersions are requested too.
+// The plan is computed by the same resolver used when installing, so it matches what would actually happen.
+func (a *app) GetDependencyResolutionPlan(modIDs []string, targetVersions map[string]string) (ResolutionPlan, error) {
+	requested := make(map[string]string, len(modIDs)+len(targetVersions))
+	for _, modID := range modIDs {
+		if modID == "" {
+			return ResolutionPlan{}, fmt.Errorf("mod ID cannot be empty")
+		}
+		requested[modID] = ">=0.0.0"
+	}
+	for modID, version := range targetVersions {
+		if modID == "" {
+			return ResolutionPlan{}, fmt.Errorf("mod ID cannot be empty")
+		}
+		requested[modID] = version
+	}
+
+	plan := ResolutionPlan{
+		Install:   []ModVersionChange{},
+		Update:    []ModVersionChange{},
+		NoChange:  []ModVersionChange{},
+		Conflicts: []ConflictInfo{},
+	}
+
+	currentMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return ResolutionPlan{}, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+
+	newLockfile, err := ficsitcli.FicsitCLI.ResolveSelectedProfileWith(requested)
+	if err != nil {
+		var solvingError resolver.DependencyResolverError
+		if !errors.As(err, &solvingError) {
+			return ResolutionPlan{}, err
+		}
+		conflictMods := make([]string, 0)
+		for _, term := range solvingError.Cause().Terms() {
+			conflictMods = append(conflictMods, term.Dependency())
+		}
+		plan.Conflicts = append(plan.Conflicts, ConflictInfo{
+			ModIDs:  conflictMods,
+			Message: solvingError.Error(),
+		})
+		return plan, nil
+	}
+
+	newDependencies := installedModDependencies(newLockfile.Mods)
+	newModIDs := maps.Keys(newLockfile.Mods)
+	slices.Sort(newModIDs)
+	for _, modID := range newModIDs {
+		change := ModVersionChange{
+			ModID:     modID,
+			ToVersion: newLockfile.Mods[modID].Version,
+			Reason:    changeReason(modID, requested, newDependencies),
+		}
+		currentMod, ok := currentMods[modID]
+		switch {
+		case !ok:
+			plan.Install = append(plan.Install, change)
+		case currentMod.Version != change.ToVersion:
+			change.FromVersion = currentMod.Version
+			plan.Update = append(plan.Update, change)
+		default:
+			change.FromVersion = currentMod.Version
+			plan.NoChange = append(plan.NoChange, change)
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type ResourcePackInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	HasTextures bool   `json:"hasTextures"`
+	HasSounds   bool   `json:"hasSounds"`
+	SizeBytes   int64  `json:"sizeBytes"`
+}
+
+const (
+	resourcePackDir      = "ResourcePack/"
+	resourcePackMetadata = resourcePackDir + "pack.json"
+)
+
+var (
+	textureExtensions = []string{".png", ".jpg", ".jpeg", ".tga", ".dds", ".bmp"}
+	soundExtensions   = []string{".wav", ".ogg", ".mp3", ".flac", ".bnk", ".wem"}
+)
+
+// GetModResourcePack inspects the ResourcePack directory of a mod version's archive.
+// Satisfactory has no standard resource pack format, so the name and description come from an optional ResourcePack/pack.json,
+// and default to the mod reference. Mods without a ResourcePack directory return an empty ResourcePackInfo.
+// An empty version means the installed version.
+func (a *app) GetModResourcePack(modID, version string) (ResourcePackInfo, error) {
+	if modID == "" {
+		return ResourcePackInfo{}, fmt.Errorf("mod ID cannot be empty")
+	}
+
+	var info ResourcePackInfo
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		info, err = readResourcePack(archivePath, modID)
+		return err
+	})
+	if err != nil {
+		return ResourcePackInfo{}, err //nolint:wrapcheck
+	}
+	return info, nil
+}
+
+func readResourcePack(archivePath, modID string) (ResourcePackInfo, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return ResourcePackInfo{}, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	var info ResourcePackInfo
+	var metadataFile *zip.File
+	found := false
+	for _, file := range archive.File {
+		if !strings.HasPrefix(file.Name, resourcePackDir) {
+			continue
+		}
+		found = true
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if file.Name == resourcePackMetadata {
+			metadataFile = file
+		}
+		info.SizeBytes += int64(file.UncompressedSize64)
+		ext := strings.ToLower(path.Ext(file.Name))
+		info.HasTextures = info.HasTextures || slices.Contains(textureExtensions, ext)
+		info.HasSounds = info.HasSounds || slices.Contains(soundExtensions, ext)
+	}
+	if !found {
+		return ResourcePackInfo{}, nil
+	}
+
+	info.Name = modID
+	if metadataFile != nil {
+		var metadata struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+		}
+		err := readZipJSON(metadataFile, &metadata)
+		if err != nil {
+			// The pack is still usable without its metadata
+			slog.Warn("failed to read resource pack metadata", slog.String("mod", modID), slog.Any("error", err))
+		} else {
+			if metadata.Name != "" {
+				info.Name = metadata.Name
+			}
+			info.Description = metadata.Description
+		}
+	}
+	return info, nil
+}
+
+func readZipJSON(file *zip.File, v interface{}) error {
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer reader.Close()
+	err = json.NewDecoder(reader).Decode(v)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", file.Name, err)
+	}
+	return nil
+}
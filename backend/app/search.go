@@ -0,0 +1,194 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type SearchQuery struct {
+	Search   string `json:"search"`
+	OrderBy  string `json:"orderBy"`
+	Order    string `json:"order"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+	// ShowHidden includes the mods the user has hidden
+	ShowHidden bool `json:"showHidden"`
+	// GameVersion, if set, only keeps the mods with a version compatible with this game CL
+	GameVersion string `json:"gameVersion"`
+}
+
+// SearchResult is a page of search results, TotalCount is the number of mods matching the whole query
+type SearchResult struct {
+	Mods       []ModSummary `json:"mods"`
+	TotalCount int          `json:"totalCount"`
+	Page       int          `json:"page"`
+	PageSize   int          `json:"pageSize"`
+}
+
+// Sort fields supported by ficsit.app's ModFilter
+var searchOrderByFields = []string{
+	"created_at",
+	"updated_at",
+	"name",
+	"views",
+	"downloads",
+	"hotness",
+	"popularity",
+	"last_version_date",
+	"search",
+}
+
+const defaultSearchPageSize = 20
+
+func normalizePagination(page, pageSize int) (int, int) {
+	if page < 0 {
+		page = 0
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	pageSize = min(pageSize, ficsitAPIMaxLimit)
+	return page, pageSize
+}
+
+type compatibleModsCacheKey struct {
+	search      string
+	orderBy     string
+	order       string
+	gameVersion string
+}
+
+// Filtering by game version needs all the matching mods, so they are kept for the following pages
+var compatibleModsCache = utils.NewTTLCache[compatibleModsCacheKey, []ficsitMod](30 * time.Minute)
+
+func (a *app) SearchMods(query SearchQuery) (SearchResult, error) {
+	page, pageSize := normalizePagination(query.Page, query.PageSize)
+
+	filter := map[string]interface{}{}
+	if query.Search != "" {
+		filter["search"] = query.Search
+	}
+	if query.OrderBy != "" {
+		if !slices.Contains(searchOrderByFields, query.OrderBy) {
+			return SearchResult{}, fmt.Errorf("unsupported sort field: %s", query.OrderBy)
+		}
+		filter["order_by"] = query.OrderBy
+	}
+	if query.Order != "" {
+		if query.Order != "asc" && query.Order != "desc" {
+			return SearchResult{}, fmt.Errorf("unsupported sort order: %s", query.Order)
+		}
+		filter["order"] = query.Order
+	}
+
+	var mods []ficsitMod
+	var count int
+	switch {
+	case query.GameVersion != "":
+		// ficsit.app cannot filter by game version, so all the matching mods are filtered here before paginating
+		compatible, err := compatibleModsCache.GetOrCompute(compatibleModsCacheKey{query.Search, query.OrderBy, query.Order, query.GameVersion}, func() ([]ficsitMod, error) {
+			compatible, _, err := scanMods(filter, func(mods []ficsitMod) ([]ficsitMod, error) {
+				return filterModsByGameVersion(mods, query.GameVersion)
+			}, -1)
+			return compatible, err
+		})
+		if err != nil {
+			return SearchResult{}, err
+		}
+		if !query.ShowHidden {
+			compatible, _ = withoutHiddenMods(compatible)
+		}
+		mods = paginateMods(compatible, page, pageSize)
+		count = len(compatible)
+	case !query.ShowHidden && len(settings.Settings.HiddenMods) > 0:
+		// ficsit.app cannot exclude mods, so the hidden ones are skipped here and the page is filled with the following mods
+		visible, matchingCount, err := scanMods(filter, withoutHiddenMods, (page+1)*pageSize)
+		if err != nil {
+			return SearchResult{}, err
+		}
+		hiddenCount, err := countMatchingHiddenMods(filter)
+		if err != nil {
+			return SearchResult{}, err
+		}
+		mods = paginateMods(visible, page, pageSize)
+		count = matchingCount - hiddenCount
+	default:
+		pageFilter := maps.Clone(filter)
+		pageFilter["limit"] = pageSize
+		pageFilter["offset"] = page * pageSize
+		var err error
+		mods, count, err = queryMods(pageFilter)
+		if err != nil {
+			return SearchResult{}, err
+		}
+	}
+
+	return SearchResult{
+		Mods:       withUserState(toModSummaries(mods), query.ShowHidden),
+		TotalCount: count,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+// scanMods fetches the mods matching the filter from the start, in the filter's order, and keeps those returned by keep.
+// It stops once it has kept needed mods, or fetches all matching mods if needed is negative.
+// The returned count is the number of mods matching the filter, before keep.
+func scanMods(filter map[string]interface{}, keep func([]ficsitMod) ([]ficsitMod, error), needed int) ([]ficsitMod, int, error) {
+	result := make([]ficsitMod, 0)
+	count := 0
+	for offset := 0; needed < 0 || len(result) < needed; offset += ficsitAPIMaxLimit {
+		batchFilter := maps.Clone(filter)
+		batchFilter["limit"] = ficsitAPIMaxLimit
+		batchFilter["offset"] = offset
+		mods, batchCount, err := queryMods(batchFilter)
+		if err != nil {
+			return nil, 0, err
+		}
+		count = batchCount
+		kept, err := keep(mods)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, kept...)
+		if len(mods) < ficsitAPIMaxLimit {
+			break
+		}
+	}
+	return result, count, nil
+}
+
+func withoutHiddenMods(mods []ficsitMod) ([]ficsitMod, error) {
+	return slices.DeleteFunc(slices.Clone(mods), func(mod ficsitMod) bool {
+		return slices.Contains(settings.Settings.HiddenMods, mod.ModReference)
+	}), nil
+}
+
+// countMatchingHiddenMods counts the hidden mods that match the filter, by running it on only the hidden mods
+func countMatchingHiddenMods(filter map[string]interface{}) (int, error) {
+	hiddenMods := settings.Settings.HiddenMods
+	count := 0
+	for start := 0; start < len(hiddenMods); start += ficsitAPIMaxLimit {
+		batch := hiddenMods[start:min(start+ficsitAPIMaxLimit, len(hiddenMods))]
+		batchFilter := maps.Clone(filter)
+		batchFilter["references"] = batch
+		batchFilter["limit"] = len(batch)
+		_, batchCount, err := queryMods(batchFilter)
+		if err != nil {
+			return 0, err
+		}
+		count += batchCount
+	}
+	return count, nil
+}
+
+func paginateMods(mods []ficsitMod, page, pageSize int) []ficsitMod {
+	start := min(page*pageSize, len(mods))
+	end := min(start+pageSize, len(mods))
+	return mods[start:end]
+}
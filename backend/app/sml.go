@@ -0,0 +1,225 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const getSMLVersionsQuery = `query GetSMLVersions {
+  getModByReference(modReference: "SML") {
+    versions(filter: { order_by: created_at, order: desc, limit: 100 }) {
+      version
+      stability
+    }
+  }
+}`
+
+// Keyed by whether only stable releases are considered
+var smlLatestVersionCache = utils.NewTTLCache[bool, string](1 * time.Hour)
+
+func (a *app) GetSMLLatestVersion() (string, error) {
+	stableOnly := settings.Settings.PreferStableReleases
+	return smlLatestVersionCache.GetOrCompute(stableOnly, func() (string, error) {
+		var response struct {
+			Mod *struct {
+				Versions []struct {
+					Version   string `json:"version"`
+					Stability string `json:"stability"`
+				} `json:"versions"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getSMLVersionsQuery, nil, &response)
+		if err != nil {
+			return "", err
+		}
+		if response.Mod == nil {
+			return "", fmt.Errorf("SML not found on ficsit.app")
+		}
+		for _, version := range response.Mod.Versions {
+			if stableOnly && version.Stability != "release" {
+				continue
+			}
+			return version.Version, nil
+		}
+		return "", fmt.Errorf("no SML version found")
+	})
+}
+
+// Release notes do not change once published, so they are never evicted
+var smlReleaseNotesCache = utils.NewTTLCache[string, string](0)
+
+// Unlike htmlTagRegex, this does not match markdown autolinks such as <https://ficsit.app>
+var markdownHTMLTagRegex = regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9-]*(\s[^>]*)?/?>`)
+
+// sanitizeMarkdown removes raw HTML from the release notes, the frontend only renders the markdown
+func sanitizeMarkdown(s string) string {
+	s = unsafeElementsRegex.ReplaceAllString(s, "")
+	s = markdownHTMLTagRegex.ReplaceAllString(s, "")
+	return strings.TrimSpace(s)
+}
+
+func (a *app) GetSMLReleaseNotes(version string) (string, error) {
+	version = strings.TrimPrefix(version, "v")
+	if version == "" {
+		return "", fmt.Errorf("version cannot be empty")
+	}
+	return smlReleaseNotesCache.GetOrCompute(version, func() (string, error) {
+		var release struct {
+			Body string `json:"body"`
+		}
+		err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/releases/tags/v%s", viper.GetString("sml-release-repo"), version), &release)
+		if err != nil {
+			return "", fmt.Errorf("failed to get SML release %s: %w", version, err)
+		}
+		return sanitizeMarkdown(release.Body), nil
+	})
+}
+
+const smlModID = "SML"
+
+// GetActiveSMLFeatures returns the SML config options that are enabled, sorted by name.
+// SML's config has no experimental features section, its options are top-level JSON booleans in Configs/SML.cfg,
+// such as developmentMode, so those are the features.
+func (a *app) GetActiveSMLFeatures() ([]string, error) {
+	config, err := a.GetModConfig(smlModID)
+	if err != nil {
+		return nil, err
+	}
+	features := make([]string, 0)
+	for key, value := range config {
+		if enabled, ok := value.(bool); ok && enabled {
+			features = append(features, key)
+		}
+	}
+	slices.Sort(features)
+	return features, nil
+}
+
+// SetSMLFeature sets one of the boolean options of SML's config, keeping the others as they are.
+// Only options SML has already written to the config can be set.
+func (a *app) SetSMLFeature(flag string, enabled bool) error {
+	config, err := a.GetModConfig(smlModID)
+	if err != nil {
+		return err
+	}
+	value, ok := config[flag]
+	if !ok {
+		return fmt.Errorf("unknown SML feature %s", flag)
+	}
+	if _, ok := value.(bool); !ok {
+		return fmt.Errorf("SML config option %s is not a feature flag", flag)
+	}
+	config[flag] = enabled
+	return a.SetModConfig(smlModID, config)
+}
+
+type ChangelogEntry struct {
+	Version    string    `json:"version"`
+	Changelog  string    `json:"changelog"`
+	ReleasedAt time.Time `json:"releasedAt"`
+}
+
+const smlReleasesPageSize = 100
+
+// Keyed by release repo. New releases are published, so the list is refreshed,
+// but the notes of each release are also kept in smlReleaseNotesCache
+var smlReleasesCache = utils.NewTTLCache[string, []ChangelogEntry](1 * time.Hour)
+
+func getSMLReleases() ([]ChangelogEntry, error) {
+	repo := viper.GetString("sml-release-repo")
+	return smlReleasesCache.GetOrCompute(repo, func() ([]ChangelogEntry, error) {
+		entries := make([]ChangelogEntry, 0)
+		for page := 1; ; page++ {
+			var releases []struct {
+				TagName     string    `json:"tag_name"`
+				Body        string    `json:"body"`
+				Draft       bool      `json:"draft"`
+				PublishedAt time.Time `json:"published_at"`
+			}
+			err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/releases?per_page=%d&page=%d", repo, smlReleasesPageSize, page), &releases)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get SML releases: %w", err)
+			}
+			for _, release := range releases {
+				version := strings.TrimPrefix(release.TagName, "v")
+				if release.Draft || version == "" {
+					continue
+				}
+				changelog := sanitizeMarkdown(release.Body)
+				smlReleaseNotesCache.Set(version, changelog)
+				entries = append(entries, ChangelogEntry{
+					Version:    version,
+					Changelog:  changelog,
+					ReleasedAt: release.PublishedAt,
+				})
+			}
+			if len(releases) < smlReleasesPageSize {
+				break
+			}
+		}
+		return entries, nil
+	})
+}
+
+// GetSMLChangelog returns the release notes of the SML versions between fromVersion and toVersion, inclusive, newest first.
+// An empty fromVersion or toVersion leaves that end of the range open.
+func (a *app) GetSMLChangelog(fromVersion, toVersion string) ([]ChangelogEntry, error) {
+	parseBound := func(version string) (*semver.Version, error) {
+		version = strings.TrimPrefix(version, "v")
+		if version == "" {
+			return nil, nil
+		}
+		parsed, err := semver.NewVersion(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SML version %s: %w", version, err)
+		}
+		return parsed, nil
+	}
+	from, err := parseBound(fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseBound(toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := getSMLReleases()
+	if err != nil {
+		return nil, err
+	}
+
+	type versionedEntry struct {
+		version *semver.Version
+		entry   ChangelogEntry
+	}
+	matching := make([]versionedEntry, 0)
+	for _, release := range releases {
+		version, err := semver.NewVersion(release.Version)
+		if err != nil {
+			continue
+		}
+		if (from != nil && version.LessThan(from)) || (to != nil && version.GreaterThan(to)) {
+			continue
+		}
+		matching = append(matching, versionedEntry{version, release})
+	}
+	slices.SortFunc(matching, func(a, b versionedEntry) int {
+		return b.version.Compare(a.version)
+	})
+
+	changelog := make([]ChangelogEntry, 0, len(matching))
+	for _, match := range matching {
+		changelog = append(changelog, match.entry)
+	}
+	return changelog, nil
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type SMLGameVersionEntry struct {
+	SMLVersion string `json:"smlVersion"`
+	Stability  string `json:"stability"`
+	// GameVersion is the ficsit.app game version constraint of the SML version, empty if it has none
+	GameVersion string `json:"gameVersion"`
+}
+
+type SMLCompatibilityMatrix struct {
+	// Entries are sorted newest SML version first
+	Entries []SMLGameVersionEntry `json:"entries"`
+}
+
+func (e SMLGameVersionEntry) supports(gameVersion int) bool {
+	if e.GameVersion == "" {
+		return true
+	}
+	constraint, err := semver.NewConstraint(e.GameVersion)
+	if err != nil {
+		slog.Warn("failed to parse SML game version constraint", slog.String("version", e.SMLVersion), slog.String("constraint", e.GameVersion), slog.Any("error", err))
+		return false
+	}
+	version, err := parseGameVersion(strconv.Itoa(gameVersion))
+	if err != nil {
+		return false
+	}
+	return constraint.Check(version)
+}
+
+var ErrNoCompatibleSML = fmt.Errorf("no SML version is compatible with the installed game version")
+
+const getSMLGameVersionsQuery = `query GetSMLGameVersions {
+  getModByReference(modReference: "SML") {
+    versions(filter: { order_by: created_at, order: desc, limit: 100 }) {
+      version
+      stability
+      game_version
+    }
+  }
+}`
+
+var smlCompatibilityCache = utils.NewTTLCache[string, SMLCompatibilityMatrix](6 * time.Hour)
+
+// The last fetched matrix is kept on disk, so it is available offline
+func smlCompatibilityFile() string {
+	return filepath.Join(viper.GetString("smm-cache-dir"), "sml_game_versions.json")
+}
+
+func fetchSMLCompatibilityMatrix() (SMLCompatibilityMatrix, error) {
+	var response struct {
+		Mod *struct {
+			Versions []struct {
+				Version     string `json:"version"`
+				Stability   string `json:"stability"`
+				GameVersion string `json:"game_version"`
+			} `json:"versions"`
+		} `json:"getModByReference"`
+	}
+	err := queryFicsitAPI(getSMLGameVersionsQuery, nil, &response)
+	if err != nil {
+		return SMLCompatibilityMatrix{}, err
+	}
+	if response.Mod == nil {
+		return SMLCompatibilityMatrix{}, fmt.Errorf("SML not found on ficsit.app")
+	}
+
+	matrix := SMLCompatibilityMatrix{
+		Entries: make([]SMLGameVersionEntry, 0, len(response.Mod.Versions)),
+	}
+	for _, version := range response.Mod.Versions {
+		matrix.Entries = append(matrix.Entries, SMLGameVersionEntry{
+			SMLVersion:  version.Version,
+			Stability:   version.Stability,
+			GameVersion: version.GameVersion,
+		})
+	}
+	return matrix, nil
+}
+
+func readSavedSMLCompatibilityMatrix() (SMLCompatibilityMatrix, error) {
+	data, err := os.ReadFile(smlCompatibilityFile())
+	if err != nil {
+		return SMLCompatibilityMatrix{}, fmt.Errorf("failed to read saved SML compatibility: %w", err)
+	}
+	var matrix SMLCompatibilityMatrix
+	err = json.Unmarshal(data, &matrix)
+	if err != nil {
+		return SMLCompatibilityMatrix{}, fmt.Errorf("failed to parse saved SML compatibility: %w", err)
+	}
+	return matrix, nil
+}
+
+// GetSMLCompatibilityMatrix returns the game versions supported by each of the latest SML versions.
+// When ficsit.app cannot be reached, the last fetched matrix is used.
+func (a *app) GetSMLCompatibilityMatrix() (SMLCompatibilityMatrix, error) {
+	return smlCompatibilityCache.GetOrCompute("", func() (SMLCompatibilityMatrix, error) {
+		matrix, err := fetchSMLCompatibilityMatrix()
+		if err != nil {
+			saved, savedErr := readSavedSMLCompatibilityMatrix()
+			if savedErr != nil {
+				return SMLCompatibilityMatrix{}, errors.Join(err, savedErr)
+			}
+			slog.Info("using saved SML compatibility", slog.Any("error", err))
+			return saved, nil
+		}
+
+		data, err := utils.JSONMarshal(matrix, 2)
+		if err == nil {
+			err = os.WriteFile(smlCompatibilityFile(), data, 0o644)
+		}
+		if err != nil {
+			slog.Warn("failed to save SML compatibility", slog.Any("error", err))
+		}
+		return matrix, nil
+	})
+}
+
+// GetLatestCompatibleSMLVersion returns the newest SML version that supports the game version of the selected installation,
+// only considering stable releases if PreferStableReleases is set
+func (a *app) GetLatestCompatibleSMLVersion() (string, error) {
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info == nil {
+		return "", fmt.Errorf("the game version of the selected installation is unknown")
+	}
+	matrix, err := a.GetSMLCompatibilityMatrix()
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range matrix.Entries {
+		if settings.Settings.PreferStableReleases && entry.Stability != "release" {
+			continue
+		}
+		if entry.supports(meta.Info.Version) {
+			return entry.SMLVersion, nil
+		}
+	}
+	return "", ErrNoCompatibleSML
+}
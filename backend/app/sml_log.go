@@ -0,0 +1,181 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	appCommon "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+const defaultSMLLogLineCount = 500
+
+var (
+	smlLogWatcher   *fsnotify.Watcher
+	smlLogWatcherMu sync.Mutex
+)
+
+// smlLogPath returns the log of the selected installation. SML has no log file of its own, it logs to the game's FactoryGame.log.
+// Only local installations are supported, since the file is watched for changes.
+func smlLogPath() (string, error) {
+	meta := ficsitcli.FicsitCLI.GetCurrentInstallationMetadata()
+	if meta.Info == nil {
+		return "", ErrGameDirectoryNotFound
+	}
+	if meta.Info.Location != common.LocationTypeLocal {
+		return "", fmt.Errorf("cannot read the log of a remote installation")
+	}
+	return filepath.Join(meta.Info.SavedPath, "Logs", "FactoryGame.log"), nil
+}
+
+// readLastLines returns the last n lines of the file, and the offset the file was read up to
+func readLastLines(path string, n int) ([]string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	lines := make([]string, 0, n)
+	var offset int64
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// A partial last line is read again by the stream once it is complete
+				break
+			}
+			return nil, 0, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		offset += int64(len(line))
+		if len(lines) == n {
+			lines = lines[1:]
+		}
+		lines = append(lines, strings.TrimRight(line, "\r\n"))
+	}
+	return lines, offset, nil
+}
+
+// GetSMLLogOutput returns the last lines of the game log of the selected installation, and starts emitting the lines written
+// after them as smlLogLine events, until StopSMLLogStream is called
+func (a *app) GetSMLLogOutput() (string, error) {
+	logPath, err := smlLogPath()
+	if err != nil {
+		return "", err
+	}
+	lineCount := settings.Settings.SMLLogLineCount
+	if lineCount <= 0 {
+		lineCount = defaultSMLLogLineCount
+	}
+	lines, offset, err := readLastLines(logPath, lineCount)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("the game has not written a log yet")
+		}
+		return "", err
+	}
+
+	err = startSMLLogStream(logPath, offset)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func startSMLLogStream(logPath string, offset int64) error {
+	smlLogWatcherMu.Lock()
+	defer smlLogWatcherMu.Unlock()
+	if smlLogWatcher != nil {
+		_ = smlLogWatcher.Close()
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create log watcher: %w", err)
+	}
+	// The directory is watched, since the game replaces the log file on every launch
+	err = watcher.Add(filepath.Dir(logPath))
+	if err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch %s: %w", filepath.Dir(logPath), err)
+	}
+	smlLogWatcher = watcher
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != filepath.Clean(logPath) || !event.Has(fsnotify.Write|fsnotify.Create) {
+					continue
+				}
+				offset = emitNewSMLLogLines(logPath, offset)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				slog.Warn("SML log watcher error", slog.Any("error", err))
+			}
+		}
+	}()
+	return nil
+}
+
+// emitNewSMLLogLines emits the complete lines written after offset, and returns the offset they were read up to
+func emitNewSMLLogLines(logPath string, offset int64) int64 {
+	file, err := os.Open(logPath)
+	if err != nil {
+		return offset
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return offset
+	}
+	if info.Size() < offset {
+		// The game started a new log
+		offset = 0
+	}
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		return offset
+	}
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return offset
+		}
+		offset += int64(len(line))
+		appCommon.EmitEvent(appCommon.AppContext, "smlLogLine", strings.TrimRight(line, "\r\n"))
+	}
+}
+
+func (a *app) StopSMLLogStream() error {
+	smlLogWatcherMu.Lock()
+	defer smlLogWatcherMu.Unlock()
+	if smlLogWatcher == nil {
+		return nil
+	}
+	err := smlLogWatcher.Close()
+	smlLogWatcher = nil
+	if err != nil {
+		return fmt.Errorf("failed to stop log watcher: %w", err)
+	}
+	return nil
+}
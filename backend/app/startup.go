@@ -0,0 +1,67 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/notifications"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+func (a *app) GetStartupBehavior() settings.StartupBehavior {
+	return settings.Settings.StartupBehavior
+}
+
+func (a *app) SetStartupBehavior(behavior settings.StartupBehavior) error {
+	settings.Settings.StartupBehavior = behavior
+	err := settings.SaveSettings()
+	if err != nil {
+		return fmt.Errorf("failed to save startup behavior: %w", err)
+	}
+	return nil
+}
+
+// ApplyStartupBehavior runs the startup steps that need the frontend to be ready.
+// Checking for SMM updates and starting minimized are handled before the window is created.
+func (a *app) ApplyStartupBehavior() {
+	behavior := settings.Settings.StartupBehavior
+
+	emitMigrationReport()
+
+	go prefetchFeatureFlags()
+	go a.emitUnsupportedMods()
+	go a.cleanTemporaryFilesOnStartup()
+
+	if !behavior.AutoSwitchToLastProfile {
+		selectedProfile := ficsitcli.FicsitCLI.GetSelectedProfile()
+		fallbackProfile := ficsitcli.FicsitCLI.GetFallbackProfile()
+		if selectedProfile != nil && *selectedProfile != fallbackProfile {
+			err := ficsitcli.FicsitCLI.SetProfile(fallbackProfile)
+			if err != nil {
+				slog.Error("failed to switch to the default profile on startup", slog.Any("error", err))
+			}
+		}
+	}
+
+	if behavior.CheckForModUpdates {
+		go checkModUpdatesOnStartup()
+	}
+}
+
+func checkModUpdatesOnStartup() {
+	updates, err := ficsitcli.FicsitCLI.CheckForUpdates()
+	if err != nil {
+		slog.Warn("failed to check for mod updates on startup", slog.Any("error", err))
+		return
+	}
+	if len(updates) == 0 {
+		return
+	}
+	items := make([]string, 0, len(updates))
+	for _, update := range updates {
+		items = append(items, update.Item)
+	}
+	notifications.Push("modUpdateAvailable", "Mod updates available", fmt.Sprintf("Updates are available for: %s", strings.Join(items, ", ")))
+}
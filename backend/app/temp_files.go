@@ -0,0 +1,82 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+// removeTempFiles removes the .tmp files and directories under dir, left behind when SMM is closed while writing them,
+// such as by utils.WriteFileAtomic or the macOS update extraction
+func removeTempFiles(dir string) (int64, error) {
+	var freed int64
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if path == dir || !strings.HasSuffix(entry.Name(), ".tmp") {
+			return nil
+		}
+		info, err := orphanedFileInfo(path)
+		if err != nil {
+			return err
+		}
+		err = os.RemoveAll(path)
+		if err != nil {
+			slog.Warn("failed to remove temporary file", slog.String("path", path), slog.Any("error", err))
+			return nil
+		}
+		freed += info.Size
+		if entry.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return freed, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	return freed, nil
+}
+
+// CleanTemporaryFiles removes the incomplete downloads from the download cache, and the temporary files in the cache directories.
+// It returns the number of bytes freed.
+func (a *app) CleanTemporaryFiles() (int64, error) {
+	var errs []error
+
+	freed, err := ficsitcli.FicsitCLI.RemoveIncompleteDownloads()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to remove incomplete downloads: %w", err))
+	}
+	for _, dir := range []string{viper.GetString("cache-dir"), viper.GetString("smm-cache-dir")} {
+		dirFreed, err := removeTempFiles(dir)
+		freed += dirFreed
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	common.EmitEvent(common.AppContext, "tempFilesCleanedUp", freed)
+	return freed, errors.Join(errs...)
+}
+
+func (a *app) cleanTemporaryFilesOnStartup() {
+	freed, err := a.CleanTemporaryFiles()
+	if err != nil {
+		slog.Warn("failed to clean temporary files", slog.Any("error", err))
+	}
+	if freed > 0 {
+		slog.Info("cleaned temporary files", slog.Int64("bytes", freed))
+	}
+}
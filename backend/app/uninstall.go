@@ -0,0 +1,28 @@
+package app
+
+import (
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+// UninstallMod removes the mod from the selected profile.
+// Mods that depend on it are reported through uninstallWillBreak first,
+// and while they remain installed the resolver will keep the mod installed as their dependency.
+func (a *app) UninstallMod(modID string) error {
+	dependents, err := a.GetModDependents(modID)
+	if err != nil {
+		return err
+	}
+	if len(dependents) > 0 {
+		common.EmitEvent(common.AppContext, "uninstallWillBreak", modID, dependents)
+	}
+
+	// ficsit-cli removes the mod files when applying the profile
+	err = ficsitcli.FicsitCLI.RemoveMod(modID)
+	if err != nil {
+		return err
+	}
+
+	common.EmitEvent(common.AppContext, "modUninstalled", modID)
+	return nil
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type UnsupportedMod struct {
+	ModID  string `json:"modId"`
+	Reason string `json:"reason"`
+}
+
+// GetUnsupportedMods returns the installed mods whose version has no build for the selected installation's platform.
+// ficsit-cli skips these when installing, so they are in the lockfile but never loaded by the game.
+func (a *app) GetUnsupportedMods() ([]UnsupportedMod, error) {
+	targetName, err := ficsitcli.FicsitCLI.GetSelectedInstallTargetName()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installation target: %w", err)
+	}
+	lockfileMods, err := ficsitcli.FicsitCLI.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockfile: %w", err)
+	}
+
+	unsupported := make([]UnsupportedMod, 0)
+	for modID, lockedMod := range lockfileMods {
+		if _, ok := lockedMod.Targets[targetName]; ok {
+			continue
+		}
+		targets := lo.Keys(lockedMod.Targets)
+		slices.Sort(targets)
+		unsupported = append(unsupported, UnsupportedMod{
+			ModID:  modID,
+			Reason: fmt.Sprintf("Version %s is not available for %s, only for %s", lockedMod.Version, targetName, strings.Join(targets, ", ")),
+		})
+	}
+	slices.SortFunc(unsupported, func(a, b UnsupportedMod) int {
+		return strings.Compare(a.ModID, b.ModID)
+	})
+	return unsupported, nil
+}
+
+func (a *app) emitUnsupportedMods() {
+	unsupported, err := a.GetUnsupportedMods()
+	if err != nil {
+		slog.Warn("failed to check for unsupported mods", slog.Any("error", err))
+		return
+	}
+	if len(unsupported) > 0 {
+		common.EmitEvent(common.AppContext, "unsupportedModsDetected", unsupported)
+	}
+}
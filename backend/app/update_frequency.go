@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+const updateFrequencyMonths = 12
+
+// 100 is the most versions ficsit.app returns in one query, few mods release that often in a year
+const getModVersionDatesQuery = `query GetModVersionDates($modReference: ModReference!) {
+  getModByReference(modReference: $modReference) {
+    created_at
+    versions(filter: { limit: 100, order_by: created_at, order: desc }) {
+      created_at
+    }
+  }
+}`
+
+var modUpdateFrequencyCache = utils.NewTTLCache[string, float64](6 * time.Hour)
+
+// GetModUpdateFrequency returns the average number of releases per month over the last 12 months.
+// Mods created less than 12 months ago are averaged over the months since they were created.
+func (a *app) GetModUpdateFrequency(modID string) (float64, error) {
+	if modID == "" {
+		return 0, fmt.Errorf("mod ID cannot be empty")
+	}
+	return modUpdateFrequencyCache.GetOrCompute(modID, func() (float64, error) {
+		var response struct {
+			Mod *struct {
+				CreatedAt time.Time `json:"created_at"`
+				Versions  []struct {
+					CreatedAt time.Time `json:"created_at"`
+				} `json:"versions"`
+			} `json:"getModByReference"`
+		}
+		err := queryFicsitAPI(getModVersionDatesQuery, map[string]interface{}{
+			"modReference": modID,
+		}, &response)
+		if err != nil {
+			return 0, err
+		}
+		if response.Mod == nil {
+			return 0, fmt.Errorf("mod %s not found", modID)
+		}
+
+		now := time.Now()
+		since := now.AddDate(0, -updateFrequencyMonths, 0)
+		months := updateFrequencyMonths
+		if response.Mod.CreatedAt.After(since) {
+			since = response.Mod.CreatedAt
+			createdYear, createdMonth, _ := response.Mod.CreatedAt.Date()
+			months = max(1, (now.Year()-createdYear)*12+int(now.Month()-createdMonth))
+		}
+		releases := 0
+		for _, version := range response.Mod.Versions {
+			if !version.CreatedAt.Before(since) {
+				releases++
+			}
+		}
+		return float64(releases) / float64(months), nil
+	})
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type AuditEntry = ficsitcli.UpdateEvent
+
+// GetUpdateHistory returns the mod installs, updates and removals done by SMM, most recent first
+func (a *app) GetUpdateHistory() ([]ficsitcli.UpdateEvent, error) {
+	return ficsitcli.ReadUpdateHistory()
+}
+
+// GetModAuditLog returns the recorded changes of a single mod, most recent first
+func (a *app) GetModAuditLog(modID string) ([]AuditEntry, error) {
+	events, err := ficsitcli.ReadUpdateHistory()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]AuditEntry, 0)
+	for _, event := range events {
+		if event.ModID == modID {
+			entries = append(entries, event)
+		}
+	}
+	return entries, nil
+}
+
+// GetModInstallLogs returns the raw update history lines of a single mod, most recent first.
+// If since is set, only the changes after it are returned.
+func (a *app) GetModInstallLogs(modID string, since time.Time) ([]string, error) {
+	if modID == "" {
+		return nil, fmt.Errorf("mod ID cannot be empty")
+	}
+	return ficsitcli.ReadUpdateHistoryLines(func(event ficsitcli.UpdateEvent) bool {
+		return event.ModID == modID && (since.IsZero() || event.Timestamp.After(since))
+	})
+}
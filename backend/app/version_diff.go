@@ -0,0 +1,116 @@
+package app
+
+import (
+	"archive/zip"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/ficsitcli"
+)
+
+type FileChange struct {
+	Path        string `json:"path"`
+	OldSize     int64  `json:"oldSize"`
+	NewSize     int64  `json:"newSize"`
+	HashChanged bool   `json:"hashChanged"`
+}
+
+// VersionDiff lists the files that differ between two versions of a mod, sorted by path
+type VersionDiff struct {
+	Added   []string     `json:"added"`
+	Removed []string     `json:"removed"`
+	Changed []FileChange `json:"changed"`
+}
+
+type archiveEntry struct {
+	size  int64
+	crc32 uint32
+}
+
+// archiveEntries reads the files of an archive from its central directory, so none of the file data is read
+func archiveEntries(archivePath string) (map[string]archiveEntry, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mod archive: %w", err)
+	}
+	defer archive.Close()
+
+	entries := make(map[string]archiveEntry, len(archive.File))
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		entries[file.Name] = archiveEntry{
+			size:  int64(file.UncompressedSize64),
+			crc32: file.CRC32,
+		}
+	}
+	return entries, nil
+}
+
+func modVersionArchiveEntries(modID, version string) (map[string]archiveEntry, error) {
+	var entries map[string]archiveEntry
+	err := ficsitcli.WithModArchive(modID, version, func(archivePath string) error {
+		var err error
+		entries, err = archiveEntries(archivePath)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", version, err)
+	}
+	return entries, nil
+}
+
+// CompareModVersions compares the files in the archives of two versions of a mod, for the selected installation's platform.
+// The archives are downloaded to the cache if they are not cached yet.
+// Files are compared by the CRC-32 checksum stored in the archive, so their contents do not have to be extracted.
+func (a *app) CompareModVersions(modID, versionA, versionB string) (VersionDiff, error) {
+	if modID == "" {
+		return VersionDiff{}, fmt.Errorf("mod ID cannot be empty")
+	}
+	if versionA == "" || versionB == "" {
+		return VersionDiff{}, fmt.Errorf("both versions must be set")
+	}
+
+	oldEntries, err := modVersionArchiveEntries(modID, versionA)
+	if err != nil {
+		return VersionDiff{}, err
+	}
+	newEntries, err := modVersionArchiveEntries(modID, versionB)
+	if err != nil {
+		return VersionDiff{}, err
+	}
+
+	diff := VersionDiff{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]FileChange, 0),
+	}
+	for path, oldEntry := range oldEntries {
+		newEntry, ok := newEntries[path]
+		if !ok {
+			diff.Removed = append(diff.Removed, path)
+			continue
+		}
+		if oldEntry != newEntry {
+			diff.Changed = append(diff.Changed, FileChange{
+				Path:        path,
+				OldSize:     oldEntry.size,
+				NewSize:     newEntry.size,
+				HashChanged: oldEntry.crc32 != newEntry.crc32,
+			})
+		}
+	}
+	for path := range newEntries {
+		if _, ok := oldEntries[path]; !ok {
+			diff.Added = append(diff.Added, path)
+		}
+	}
+	slices.Sort(diff.Added)
+	slices.Sort(diff.Removed)
+	slices.SortFunc(diff.Changed, func(a, b FileChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return diff, nil
+}
@@ -1,6 +1,7 @@
 package autoupdate
 
 import (
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/autoupdate/source/github"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/autoupdate/updater"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/notifications"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
 )
 
@@ -37,8 +40,9 @@ func Init() {
 		enabled: shouldUseUpdater(),
 	}
 	Updater.Updater.UpdateFound.On(func(update updater.PendingUpdate) {
+		notifications.Push("updateAvailable", "Update available", fmt.Sprintf("SMM %s is available", update.Version.String()))
 		if common.AppContext != nil {
-			wailsRuntime.EventsEmit(common.AppContext, "updateAvailable", &PendingUpdate{
+			common.EmitEvent(common.AppContext, "updateAvailable", &PendingUpdate{
 				Version:    update.Version.String(),
 				Changelogs: update.Changelogs,
 			})
@@ -46,15 +50,16 @@ func Init() {
 	})
 	Updater.Updater.DownloadProgress.On(func(progress updater.UpdateDownloadProgress) {
 		if common.AppContext != nil {
-			wailsRuntime.EventsEmit(common.AppContext, "updateDownloadProgress", &utils.Progress{
+			common.EmitEvent(common.AppContext, "updateDownloadProgress", &utils.Progress{
 				Current: progress.BytesDownloaded,
 				Total:   progress.BytesTotal,
 			})
 		}
 	})
 	Updater.Updater.UpdateReady.On(func(interface{}) {
+		notifications.Push("updateReady", "Update ready", "The update will be installed when SMM restarts")
 		if common.AppContext != nil {
-			wailsRuntime.EventsEmit(common.AppContext, "updateReady")
+			common.EmitEvent(common.AppContext, "updateReady")
 		}
 	})
 }
@@ -144,6 +149,10 @@ func (u *autoUpdate) CheckInterval(interval time.Duration) {
 			case <-u.updateCheckStop:
 				return
 			case <-u.updateCheckTicker.C:
+				autoUpdate := settings.Settings.AutoUpdate
+				if !autoUpdate.UpdateManagerAutomatically || !autoUpdate.InWindow(time.Now()) {
+					continue
+				}
 				err := Updater.CheckForUpdate()
 				if err != nil {
 					slog.Error("failed to check for update", slog.Any("error", err))
@@ -0,0 +1,90 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type EventLogEntry struct {
+	Name string `json:"name"`
+	// Payload is the JSON array of the event arguments
+	Payload   string    `json:"payload"`
+	EmittedAt time.Time `json:"emittedAt"`
+	// Count is how many times the event was emitted in a row, only the last one is kept
+	Count int `json:"count"`
+}
+
+const maxEventLogEntries = 200
+
+// Streaming and frequently emitted events would push everything else out of the log, so they are not recorded
+var unloggedEvents = map[string]bool{
+	"progress":   true,
+	"smlLogLine": true,
+}
+
+// Periodic events replace the previous entry of the same event, if nothing else was emitted in between
+var coalescedEvents = map[string]bool{
+	"isGameRunning": true,
+	"cacheStats":    true,
+}
+
+var (
+	eventLog      = make([]EventLogEntry, 0, maxEventLogEntries)
+	eventLogStart int
+	eventLogMu    sync.Mutex
+)
+
+func recordEvent(name string, args []interface{}) {
+	if unloggedEvents[name] {
+		return
+	}
+	// The arguments are marshaled right away, they can be changed after the event is emitted
+	payload, err := json.Marshal(args)
+	if err != nil {
+		payload = []byte(err.Error())
+	}
+	entry := EventLogEntry{
+		Name:      name,
+		Payload:   string(payload),
+		EmittedAt: time.Now(),
+		Count:     1,
+	}
+
+	eventLogMu.Lock()
+	defer eventLogMu.Unlock()
+	if len(eventLog) > 0 && coalescedEvents[name] {
+		last := (eventLogStart + len(eventLog) - 1) % maxEventLogEntries
+		if eventLog[last].Name == name {
+			entry.Count = eventLog[last].Count + 1
+			eventLog[last] = entry
+			return
+		}
+	}
+	if len(eventLog) < maxEventLogEntries {
+		eventLog = append(eventLog, entry)
+	} else {
+		eventLog[eventLogStart] = entry
+		eventLogStart = (eventLogStart + 1) % maxEventLogEntries
+	}
+}
+
+// EmitEvent records the event in the event log, then emits it to the frontend.
+// All events should be emitted through this, so the event log is complete apart from the unlogged events.
+func EmitEvent(ctx context.Context, name string, args ...interface{}) {
+	recordEvent(name, args)
+	wailsRuntime.EventsEmit(ctx, name, args...)
+}
+
+// GetEventLog returns the last emitted events, oldest first
+func GetEventLog() []EventLogEntry {
+	eventLogMu.Lock()
+	defer eventLogMu.Unlock()
+	entries := make([]EventLogEntry, 0, len(eventLog))
+	entries = append(entries, eventLog[eventLogStart:]...)
+	entries = append(entries, eventLog[:eventLogStart]...)
+	return entries
+}
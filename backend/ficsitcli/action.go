@@ -4,13 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/puzpuzpuz/xsync/v3"
 	"github.com/satisfactorymodding/ficsit-cli/cli"
 	resolver "github.com/satisfactorymodding/ficsit-resolver"
-	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 
@@ -44,9 +44,16 @@ func (f *ficsitCLI) action(action Action, item ProgressItem, run func(*slog.Logg
 
 	progress := newProgress(action, item)
 	tasks := xsync.NewMapOf[string, utils.Progress]()
+	f.runningAction.Store(&runningAction{
+		action:    action,
+		item:      item,
+		tasks:     tasks,
+		startedAt: time.Now(),
+	})
+	defer f.runningAction.Store(nil)
 	go func() {
-		wailsRuntime.EventsEmit(common.AppContext, "progress", progress)
-		defer wailsRuntime.EventsEmit(common.AppContext, "progress", nil)
+		common.EmitEvent(common.AppContext, "progress", progress)
+		defer common.EmitEvent(common.AppContext, "progress", nil)
 
 		progressTicker := time.NewTicker(100 * time.Millisecond)
 		defer progressTicker.Stop()
@@ -60,7 +67,7 @@ func (f *ficsitCLI) action(action Action, item ProgressItem, run func(*slog.Logg
 					progress.Tasks[key] = value
 					return true
 				})
-				wailsRuntime.EventsEmit(common.AppContext, "progress", progress)
+				common.EmitEvent(common.AppContext, "progress", progress)
 			}
 		}
 	}()
@@ -72,7 +79,10 @@ func (f *ficsitCLI) action(action Action, item ProgressItem, run func(*slog.Logg
 		}
 	}()
 
+	lockfileBefore := f.snapshotLockfile()
 	err := run(l, taskChannel)
+	// Failed actions can still have applied some changes
+	f.recordLockfileChanges(lockfileBefore)
 	if err != nil {
 		l.Info("action failed")
 		return err
@@ -91,6 +101,43 @@ func (f *ficsitCLI) Apply() error {
 	return f.action(ActionApply, newSimpleItem(*profileName), f.apply)
 }
 
+// ReinstallMods removes the given mods from the selected installation, then applies the profile to extract them again.
+// Applying alone keeps the files of a mod whose .smm marker matches its locked version, even if some of them are missing.
+func (f *ficsitCLI) ReinstallMods(mods []string) error {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return fmt.Errorf("no installation selected")
+	}
+	profileName := f.GetSelectedProfile()
+	if profileName == nil {
+		return fmt.Errorf("no profile selected")
+	}
+	return f.action(ActionApply, newSimpleItem(*profileName), func(l *slog.Logger, taskChannel chan<- taskUpdate) error {
+		d, err := selectedInstallation.GetDisk()
+		if err != nil {
+			return fmt.Errorf("failed to get disk for installation: %w", err)
+		}
+		modsDir := filepath.Join(selectedInstallation.BasePath(), "FactoryGame", "Mods")
+		for _, mod := range mods {
+			// Removing the mod directory also removes its .smm marker
+			modDir := filepath.Join(modsDir, mod)
+			exists, err := d.Exists(modDir)
+			if err != nil {
+				return fmt.Errorf("failed to check %s: %w", modDir, err)
+			}
+			if !exists {
+				continue
+			}
+			l.Info("removing mod to reinstall it", slog.String("mod", mod))
+			err = d.Remove(modDir)
+			if err != nil {
+				return fmt.Errorf("failed to remove %s: %w", mod, err)
+			}
+		}
+		return f.apply(l, taskChannel)
+	})
+}
+
 func (f *ficsitCLI) apply(l *slog.Logger, taskChannel chan<- taskUpdate) error {
 	installsToApply, profile, err := f.getInstallsToApply()
 	if err != nil {
@@ -124,9 +171,14 @@ func (f *ficsitCLI) apply(l *slog.Logger, taskChannel chan<- taskUpdate) error {
 			installChannel := make(chan cli.InstallUpdate)
 
 			go func() {
+				// Only the archives that are not cached are downloaded, but every installed mod is extracted
+				downloaded := make(map[cli.InstallUpdateItem]bool)
+				extracted := make(map[cli.InstallUpdateItem]bool)
+				defer recordCacheUsage(downloaded, extracted)
 				for update := range installChannel {
 					switch update.Type {
 					case cli.InstallUpdateTypeModDownload:
+						downloaded[update.Item] = true
 						taskChannel <- taskUpdate{
 							taskName: fmt.Sprintf("%s:%s:%s:download", update.Item.Mod, update.Item.Version, installTarget.targetName),
 							progress: utils.Progress{
@@ -135,6 +187,7 @@ func (f *ficsitCLI) apply(l *slog.Logger, taskChannel chan<- taskUpdate) error {
 							},
 						}
 					case cli.InstallUpdateTypeModExtract:
+						extracted[update.Item] = true
 						taskChannel <- taskUpdate{
 							taskName: fmt.Sprintf("%s:%s:%s:extract", update.Item.Mod, update.Item.Version, installTarget.targetName),
 							progress: utils.Progress{
@@ -154,6 +207,7 @@ func (f *ficsitCLI) apply(l *slog.Logger, taskChannel chan<- taskUpdate) error {
 				}
 				return installErr //nolint:wrapcheck
 			}
+			f.touchInstalledArchives(installTarget)
 			return nil
 		})
 	}
@@ -0,0 +1,54 @@
+package ficsitcli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/puzpuzpuz/xsync/v3"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type runningAction struct {
+	action Action
+	item   ProgressItem
+	// Keyed by mod:version:target:step, like the progress event tasks
+	tasks     *xsync.MapOf[string, utils.Progress]
+	startedAt time.Time
+}
+
+type ModProgress struct {
+	Action    Action
+	StartedAt time.Time
+	// Steps are keyed by step name, download or extract, and summed over the targets being installed
+	Steps map[string]utils.Progress
+}
+
+// GetModProgress returns the progress of the running action for a mod.
+// A mod is part of the action if it is the action's item, or if the action has a task for it.
+func (f *ficsitCLI) GetModProgress(modReference string) (ModProgress, bool) {
+	running := f.runningAction.Load()
+	if running == nil {
+		return ModProgress{}, false
+	}
+	progress := ModProgress{
+		Action:    running.action,
+		StartedAt: running.startedAt,
+		Steps:     make(map[string]utils.Progress),
+	}
+	running.tasks.Range(func(key string, value utils.Progress) bool {
+		parts := strings.Split(key, ":")
+		if len(parts) != 4 || parts[0] != modReference {
+			return true
+		}
+		step := progress.Steps[parts[3]]
+		step.Current += value.Current
+		step.Total += value.Total
+		progress.Steps[parts[3]] = step
+		return true
+	})
+	if len(progress.Steps) == 0 && running.item.Name != modReference {
+		return ModProgress{}, false
+	}
+	return progress, true
+}
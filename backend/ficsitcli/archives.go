@@ -0,0 +1,237 @@
+package ficsitcli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	ficsitcache "github.com/satisfactorymodding/ficsit-cli/cli/cache"
+	resolver "github.com/satisfactorymodding/ficsit-resolver"
+	"github.com/spf13/viper"
+)
+
+// modArchiveVersion returns the version to use for a mod archive, an empty version means the installed version,
+// and the target name of the selected installation's platform
+func (f *ficsitCLI) modArchiveVersion(modReference string, version string) (string, string, error) {
+	if version == "" {
+		lockfileMods, err := f.GetSelectedInstallLockfileMods()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get lockfile: %w", err)
+		}
+		lockedMod, ok := lockfileMods[modReference]
+		if !ok {
+			return "", "", fmt.Errorf("mod %s is not installed", modReference)
+		}
+		version = lockedMod.Version
+	}
+
+	targetName, err := f.GetSelectedInstallTargetName()
+	if err != nil {
+		return "", "", err
+	}
+	return version, targetName, nil
+}
+
+// GetModVersions returns the versions of a mod with their dependencies and targets, as seen by the resolver.
+// It uses the local registry when offline.
+func (f *ficsitCLI) GetModVersions(modReference string) ([]resolver.ModVersion, error) {
+	modVersions, err := f.ficsitCli.Provider.ModVersionsWithDependencies(context.TODO(), modReference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mod versions: %w", err)
+	}
+	return modVersions, nil
+}
+
+func (f *ficsitCLI) GetModVersion(modReference string, version string) (resolver.ModVersion, error) {
+	modVersions, err := f.GetModVersions(modReference)
+	if err != nil {
+		return resolver.ModVersion{}, err
+	}
+	for _, modVersion := range modVersions {
+		if modVersion.Version == version {
+			return modVersion, nil
+		}
+	}
+	return resolver.ModVersion{}, fmt.Errorf("version %s of %s not found", version, modReference)
+}
+
+func (f *ficsitCLI) findModTarget(modReference string, version string, targetName string) (resolver.Target, error) {
+	modVersion, err := f.GetModVersion(modReference, version)
+	if err != nil {
+		return resolver.Target{}, err
+	}
+	for _, target := range modVersion.Targets {
+		if string(target.TargetName) == targetName {
+			return target, nil
+		}
+	}
+	return resolver.Target{}, fmt.Errorf("%s@%s is not available for %s", modReference, version, targetName)
+}
+
+// Same cache key that ficsit-cli uses when installing mods
+func modArchiveCachePath(modReference string, version string, targetName string) string {
+	cacheKey := modReference + "_" + version + "_" + targetName + ".zip"
+	return filepath.Join(viper.GetString("cache-dir"), "downloadCache", cacheKey)
+}
+
+// archivesLock keeps archives from being removed from the download cache while they are downloaded or read outside of an action
+var archivesLock sync.RWMutex
+
+// GetModArchivePath returns the path of the cached archive of a mod version for the selected installation's platform.
+// If the archive is not cached, it is downloaded first. An empty version means the installed version.
+// The archive can be evicted from the cache once this returns, use WithModArchive to read it.
+func (f *ficsitCLI) GetModArchivePath(modReference string, version string) (string, error) {
+	archivesLock.RLock()
+	defer archivesLock.RUnlock()
+	return f.downloadModArchive(modReference, version)
+}
+
+// WithModArchive calls fn with the path of the cached archive of a mod version, like GetModArchivePath.
+// The archive is not removed from the download cache until fn returns.
+func WithModArchive(modReference string, version string, fn func(archivePath string) error) error {
+	archivesLock.RLock()
+	defer archivesLock.RUnlock()
+	archivePath, err := FicsitCLI.downloadModArchive(modReference, version)
+	if err != nil {
+		return fmt.Errorf("failed to get mod archive: %w", err)
+	}
+	return fn(archivePath)
+}
+
+func (f *ficsitCLI) downloadModArchive(modReference string, version string) (string, error) {
+	version, targetName, err := f.modArchiveVersion(modReference, version)
+	if err != nil {
+		return "", err
+	}
+
+	archivePath := modArchiveCachePath(modReference, version, targetName)
+
+	_, err = os.Stat(archivePath)
+	if err == nil {
+		cacheHits.Add(1)
+		return archivePath, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to stat cached archive: %w", err)
+	}
+	cacheMisses.Add(1)
+
+	target, err := f.findModTarget(modReference, version, targetName)
+	if err != nil {
+		return "", err
+	}
+	file, _, err := ficsitcache.DownloadOrCache(filepath.Base(archivePath), target.Hash, target.Link, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s@%s: %w", modReference, version, err)
+	}
+	_ = file.Close()
+	return archivePath, nil
+}
+
+// GetModArchiveHash returns the hex SHA-256 of a mod version's archive for the selected installation's platform,
+// as listed by ficsit.app. If that cannot be fetched, it is computed from the cached archive instead.
+// An empty version means the installed version.
+func (f *ficsitCLI) GetModArchiveHash(modReference string, version string) (string, error) {
+	version, targetName, err := f.modArchiveVersion(modReference, version)
+	if err != nil {
+		return "", err
+	}
+
+	target, err := f.findModTarget(modReference, version, targetName)
+	if err == nil && target.Hash != "" {
+		return target.Hash, nil
+	}
+
+	archivesLock.RLock()
+	defer archivesLock.RUnlock()
+	file, openErr := os.Open(modArchiveCachePath(modReference, version, targetName))
+	if openErr != nil {
+		if err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to open cached archive: %w", openErr)
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, file)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash cached archive: %w", err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+type ModArchiveInfo struct {
+	// Size is the download size listed by ficsit.app
+	Size int64
+	// CachedPath is the path of the cached archive, or empty if it is not cached
+	CachedPath string
+}
+
+// GetModArchiveInfo returns the download size of a mod version's archive for the selected installation's platform,
+// and where it is cached, without downloading it. An empty version means the installed version.
+func (f *ficsitCLI) GetModArchiveInfo(modReference string, version string) (ModArchiveInfo, error) {
+	version, targetName, err := f.modArchiveVersion(modReference, version)
+	if err != nil {
+		return ModArchiveInfo{}, err
+	}
+	return f.GetModArchiveInfoForTarget(modReference, version, targetName)
+}
+
+// GetModArchiveInfoForTarget is GetModArchiveInfo for the given target, instead of the selected installation's platform
+func (f *ficsitCLI) GetModArchiveInfoForTarget(modReference string, version string, targetName string) (ModArchiveInfo, error) {
+	target, err := f.findModTarget(modReference, version, targetName)
+	if err != nil {
+		return ModArchiveInfo{}, err
+	}
+	cachedPath, err := cachedModArchivePath(modReference, version, targetName)
+	if err != nil {
+		return ModArchiveInfo{}, err
+	}
+	return ModArchiveInfo{
+		Size:       target.Size,
+		CachedPath: cachedPath,
+	}, nil
+}
+
+func cachedModArchivePath(modReference string, version string, targetName string) (string, error) {
+	archivePath := modArchiveCachePath(modReference, version, targetName)
+	_, err := os.Stat(archivePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to stat cached archive: %w", err)
+	}
+	return archivePath, nil
+}
+
+// GetCachedModArchivePath returns the path of the cached archive of a mod version for the selected installation's platform,
+// or an empty string if it is not cached. Unlike GetModArchivePath, nothing is downloaded or requested from ficsit.app.
+// An empty version means the installed version.
+func (f *ficsitCLI) GetCachedModArchivePath(modReference string, version string) (string, error) {
+	version, targetName, err := f.modArchiveVersion(modReference, version)
+	if err != nil {
+		return "", err
+	}
+	return cachedModArchivePath(modReference, version, targetName)
+}
+
+// GetModDownloadLink returns the download link of a mod version's archive for the selected installation's platform.
+// An empty version means the installed version.
+func (f *ficsitCLI) GetModDownloadLink(modReference string, version string) (string, error) {
+	version, targetName, err := f.modArchiveVersion(modReference, version)
+	if err != nil {
+		return "", err
+	}
+	target, err := f.findModTarget(modReference, version, targetName)
+	if err != nil {
+		return "", err
+	}
+	return target.Link, nil
+}
@@ -0,0 +1,213 @@
+package ficsitcli
+
+import (
+	"archive/zip"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/satisfactorymodding/ficsit-cli/cli"
+	"github.com/spf13/viper"
+
+	appCommon "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+)
+
+// Download cache lookups since startup, or since the stats were last reset
+var (
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
+)
+
+type CacheStats struct {
+	Hits    int64   `json:"hits"`
+	Misses  int64   `json:"misses"`
+	HitRate float64 `json:"hitRate"`
+}
+
+func recordCacheUsage(downloaded, extracted map[cli.InstallUpdateItem]bool) {
+	for item := range extracted {
+		if downloaded[item] {
+			cacheMisses.Add(1)
+		} else {
+			cacheHits.Add(1)
+		}
+	}
+}
+
+// GetCacheStats returns how often mod archives were found in the download cache. The hit rate is 0 if there were no lookups.
+func (f *ficsitCLI) GetCacheStats() CacheStats {
+	stats := CacheStats{
+		Hits:   cacheHits.Load(),
+		Misses: cacheMisses.Load(),
+	}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
+
+func (f *ficsitCLI) ResetCacheStats() {
+	cacheHits.Store(0)
+	cacheMisses.Store(0)
+}
+
+func (f *ficsitCLI) StartCacheStatsReporter() {
+	statsTicker := time.NewTicker(1 * time.Minute)
+	go func() {
+		for range statsTicker.C {
+			appCommon.EmitEvent(appCommon.AppContext, "cacheStats", f.GetCacheStats())
+		}
+	}()
+}
+
+type CacheSizeExceeded struct {
+	CacheSize    int64 `json:"cacheSize"`
+	MaxCacheSize int64 `json:"maxCacheSize"`
+}
+
+func downloadCacheDir() string {
+	return filepath.Join(viper.GetString("cache-dir"), "downloadCache")
+}
+
+// touchInstalledArchives marks the cached archives of the installation's mods as used,
+// ficsit-cli does not update them when installing from the cache
+func (f *ficsitCLI) touchInstalledArchives(installTarget installWithTarget) {
+	lockfile, err := installTarget.install.LockFile(f.ficsitCli)
+	if err != nil || lockfile == nil {
+		return
+	}
+	now := time.Now()
+	for modReference, lockedMod := range lockfile.Mods {
+		archivePath := filepath.Join(downloadCacheDir(), modReference+"_"+lockedMod.Version+"_"+installTarget.targetName+".zip")
+		err := os.Chtimes(archivePath, now, now)
+		if err != nil && !os.IsNotExist(err) {
+			slog.Warn("failed to update cached archive time", slog.String("path", archivePath), slog.Any("error", err))
+		}
+	}
+}
+
+// EvictDownloadCache removes the least recently used archives from the download cache until it fits in the size limit.
+// Nothing is removed while another operation is in progress, since it might be installing from the cache.
+func (f *ficsitCLI) EvictDownloadCache() error {
+	if !f.actionMutex.TryLock() {
+		return fmt.Errorf("another operation in progress")
+	}
+	defer f.actionMutex.Unlock()
+	archivesLock.Lock()
+	defer archivesLock.Unlock()
+
+	maxCacheSize := settings.Settings.MaxCacheSize
+	if maxCacheSize <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(downloadCacheDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read download cache: %w", err)
+	}
+
+	archives := make([]os.FileInfo, 0, len(entries))
+	var cacheSize int64
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".zip") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		archives = append(archives, info)
+		cacheSize += info.Size()
+	}
+	if cacheSize <= maxCacheSize {
+		return nil
+	}
+
+	appCommon.EmitEvent(appCommon.AppContext, "cacheSizeExceeded", CacheSizeExceeded{
+		CacheSize:    cacheSize,
+		MaxCacheSize: maxCacheSize,
+	})
+
+	slices.SortFunc(archives, func(a, b os.FileInfo) int {
+		return a.ModTime().Compare(b.ModTime())
+	})
+	for _, archive := range archives {
+		if cacheSize <= maxCacheSize {
+			break
+		}
+		err := os.Remove(filepath.Join(downloadCacheDir(), archive.Name()))
+		if err != nil {
+			slog.Warn("failed to evict cached archive", slog.String("archive", archive.Name()), slog.Any("error", err))
+			continue
+		}
+		slog.Info("evicted cached archive", slog.String("archive", archive.Name()))
+		cacheSize -= archive.Size()
+	}
+	return nil
+}
+
+func (f *ficsitCLI) StartDownloadCacheEviction() {
+	evictionTicker := time.NewTicker(30 * time.Minute)
+	go func() {
+		for ; ; <-evictionTicker.C {
+			err := f.EvictDownloadCache()
+			if err != nil {
+				slog.Warn("failed to evict download cache", slog.Any("error", err))
+			}
+		}
+	}()
+}
+
+// RemoveIncompleteDownloads removes the archives in the download cache that cannot be opened as zips.
+// ficsit-cli downloads directly to the cache, so a crash during a download leaves a partial archive behind.
+// Nothing is removed while another operation is in progress, since it might be downloading.
+func (f *ficsitCLI) RemoveIncompleteDownloads() (int64, error) {
+	if !f.actionMutex.TryLock() {
+		return 0, fmt.Errorf("another operation in progress")
+	}
+	defer f.actionMutex.Unlock()
+	archivesLock.Lock()
+	defer archivesLock.Unlock()
+
+	entries, err := os.ReadDir(downloadCacheDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read download cache: %w", err)
+	}
+
+	var freed int64
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".zip") {
+			continue
+		}
+		archivePath := filepath.Join(downloadCacheDir(), entry.Name())
+		archive, err := zip.OpenReader(archivePath)
+		if err == nil {
+			_ = archive.Close()
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		err = os.Remove(archivePath)
+		if err != nil {
+			slog.Warn("failed to remove incomplete download", slog.String("archive", entry.Name()), slog.Any("error", err))
+			continue
+		}
+		slog.Info("removed incomplete download", slog.String("archive", entry.Name()))
+		freed += info.Size()
+	}
+	return freed, nil
+}
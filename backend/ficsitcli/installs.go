@@ -116,6 +116,28 @@ func (f *ficsitCLI) GetSelectedInstall() *cli.Installation {
 	return f.ficsitCli.Installations.GetInstallation(f.ficsitCli.Installations.SelectedInstallation)
 }
 
+// GetSelectedInstallTargetName returns the target of the selected installation's platform, such as Windows or LinuxServer
+func (f *ficsitCLI) GetSelectedInstallTargetName() (string, error) {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return "", fmt.Errorf("no installation selected")
+	}
+	return f.GetInstallationTargetName(selectedInstallation.Path)
+}
+
+// GetInstallationTargetName returns the name of the mod target for the installation's platform
+func (f *ficsitCLI) GetInstallationTargetName(installPath string) (string, error) {
+	installation := f.GetInstallation(installPath)
+	if installation == nil {
+		return "", fmt.Errorf("installation %s not found", installPath)
+	}
+	platform, err := installation.GetPlatform(f.ficsitCli)
+	if err != nil {
+		return "", fmt.Errorf("failed to get platform: %w", err)
+	}
+	return platform.TargetName, nil
+}
+
 func (f *ficsitCLI) SetModsEnabled(enabled bool) error {
 	var item ProgressItem
 	if enabled {
@@ -195,6 +217,19 @@ func (f *ficsitCLI) GetSelectedInstallLockfile() (*resolver.LockFile, error) {
 	return lockfile, nil
 }
 
+// GetInstallationLockfile returns the lockfile of an installation, or nil if nothing has been installed to it yet
+func (f *ficsitCLI) GetInstallationLockfile(path string) (*resolver.LockFile, error) {
+	installation := f.GetInstallation(path)
+	if installation == nil {
+		return nil, fmt.Errorf("installation %s not found", path)
+	}
+	lockfile, err := installation.LockFile(f.ficsitCli)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return lockfile, nil
+}
+
 func (f *ficsitCLI) LaunchGame() {
 	selectedInstallation := f.GetSelectedInstall()
 	if selectedInstallation == nil {
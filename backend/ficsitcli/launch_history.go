@@ -0,0 +1,66 @@
+package ficsitcli
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type LaunchRecord struct {
+	LaunchTime time.Time `json:"launchTime"`
+	ExitTime   time.Time `json:"exitTime"`
+	// ExitCode is always -1, as the game is detected by polling the process list,
+	// which does not expose the exit code of processes SMM did not start
+	ExitCode      int    `json:"exitCode"`
+	ActiveProfile string `json:"activeProfile"`
+	SMLVersion    string `json:"smlVersion"`
+}
+
+var launchHistory = sync.OnceValue(func() *utils.JSONLinesLog[LaunchRecord] {
+	return utils.NewJSONLinesLog[LaunchRecord](viper.GetString("launch-history-file"), 1)
+})
+
+// newLaunchRecord captures the state of the selected installation when the game is detected as started
+func (f *ficsitCLI) newLaunchRecord() LaunchRecord {
+	record := LaunchRecord{
+		LaunchTime: time.Now(),
+		ExitCode:   -1,
+	}
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return record
+	}
+	record.ActiveProfile = selectedInstallation.Profile
+	lockfileMods, err := f.GetSelectedInstallLockfileMods()
+	if err != nil {
+		slog.Warn("failed to read lockfile for launch history", slog.Any("error", err))
+		return record
+	}
+	if sml, ok := lockfileMods["SML"]; ok {
+		record.SMLVersion = sml.Version
+	}
+	return record
+}
+
+func writeLaunchRecord(record LaunchRecord) error {
+	return launchHistory().Append(record)
+}
+
+// ReadLaunchHistory returns the recorded game launches, most recent first, including those in rotated log files.
+// A limit of 0 or less returns every record
+func ReadLaunchHistory(limit int) ([]LaunchRecord, error) {
+	records, err := launchHistory().ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(records)
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	return records, nil
+}
@@ -3,8 +3,80 @@ package ficsitcli
 import (
 	"fmt"
 	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
 )
 
+// recordModInstall keeps the date the mod was first installed in the profile on the installation, changing its version does not reset it.
+// The installation path is hashed like for the remote names, since the paths of remote installations contain credentials.
+func recordModInstall(installPath, profile, mod string) {
+	if settings.Settings.ModInstallDates == nil {
+		settings.Settings.ModInstallDates = make(map[string]map[string]map[string]time.Time)
+	}
+	installKey := remoteKey(installPath)
+	if settings.Settings.ModInstallDates[installKey] == nil {
+		settings.Settings.ModInstallDates[installKey] = make(map[string]map[string]time.Time)
+	}
+	profileDates := settings.Settings.ModInstallDates[installKey][profile]
+	if profileDates == nil {
+		profileDates = make(map[string]time.Time)
+		settings.Settings.ModInstallDates[installKey][profile] = profileDates
+	}
+	if _, ok := profileDates[mod]; ok {
+		return
+	}
+	profileDates[mod] = time.Now()
+	_ = settings.SaveSettings()
+}
+
+func forgetModInstall(installPath, profile, mod string) {
+	profileDates := settings.Settings.ModInstallDates[remoteKey(installPath)][profile]
+	if _, ok := profileDates[mod]; !ok {
+		return
+	}
+	delete(profileDates, mod)
+	_ = settings.SaveSettings()
+}
+
+// renameProfileModInstallDates moves the install dates of a renamed profile, on every installation
+func renameProfileModInstallDates(oldName, newName string) {
+	changed := false
+	for _, installDates := range settings.Settings.ModInstallDates {
+		if profileDates, ok := installDates[oldName]; ok {
+			delete(installDates, oldName)
+			installDates[newName] = profileDates
+			changed = true
+		}
+	}
+	if changed {
+		_ = settings.SaveSettings()
+	}
+}
+
+func forgetProfileModInstallDates(name string) {
+	changed := false
+	for _, installDates := range settings.Settings.ModInstallDates {
+		if _, ok := installDates[name]; ok {
+			delete(installDates, name)
+			changed = true
+		}
+	}
+	if changed {
+		_ = settings.SaveSettings()
+	}
+}
+
+// GetSelectedInstallModInstallDates returns when the mods of the selected profile were installed on the selected installation, keyed by mod reference
+func (f *ficsitCLI) GetSelectedInstallModInstallDates() map[string]time.Time {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return make(map[string]time.Time)
+	}
+	return maps.Clone(settings.Settings.ModInstallDates[remoteKey(selectedInstallation.Path)][selectedInstallation.Profile])
+}
+
 func (f *ficsitCLI) InstallMod(mod string) error {
 	return f.action(ActionInstall, newSimpleItem(mod), func(l *slog.Logger, taskUpdates chan<- taskUpdate) error {
 		selectedInstallation := f.GetSelectedInstall()
@@ -39,6 +111,8 @@ func (f *ficsitCLI) InstallMod(mod string) error {
 			return installErr
 		}
 
+		recordModInstall(selectedInstallation.Path, selectedInstallation.Profile, mod)
+
 		return nil
 	})
 }
@@ -76,6 +150,8 @@ func (f *ficsitCLI) InstallModVersion(mod string, version string) error {
 			return installErr
 		}
 
+		recordModInstall(selectedInstallation.Path, selectedInstallation.Profile, mod)
+
 		return nil
 	})
 }
@@ -96,6 +172,7 @@ func (f *ficsitCLI) RemoveMod(mod string) error {
 		profile := f.GetProfile(selectedInstallation.Profile)
 
 		profile.RemoveMod(mod)
+		forgetModInstall(selectedInstallation.Path, selectedInstallation.Profile, mod)
 
 		err := f.ficsitCli.Profiles.Save()
 		if err != nil {
@@ -178,3 +255,60 @@ func (f *ficsitCLI) DisableMod(mod string) error {
 		return nil
 	})
 }
+
+// ToggleModEnabled flips the enabled state of the mod and returns the new state.
+// The state is read inside the action, so concurrent toggles cannot both act on the same previous state.
+func (f *ficsitCLI) ToggleModEnabled(mod string) (bool, error) {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return false, fmt.Errorf("no installation selected")
+	}
+
+	// The action type is only used for the progress display, the state is checked again while holding the lock
+	action := ActionEnable
+	if profileMod, ok := f.GetSelectedInstallProfileMods()[mod]; ok && profileMod.Enabled {
+		action = ActionDisable
+	}
+
+	var enabled bool
+	err := f.action(action, newSimpleItem(mod), func(l *slog.Logger, taskUpdates chan<- taskUpdate) error {
+		selectedInstallation := f.GetSelectedInstall()
+
+		if selectedInstallation == nil {
+			return fmt.Errorf("no installation selected")
+		}
+
+		l = l.With(
+			slog.String("install", selectedInstallation.Path),
+			slog.String("profile", selectedInstallation.Profile),
+		)
+
+		profile := f.GetProfile(selectedInstallation.Profile)
+
+		profileMod, ok := profile.Mods[mod]
+		if !ok {
+			return fmt.Errorf("mod %s is not installed", mod)
+		}
+		enabled = !profileMod.Enabled
+
+		profile.SetModEnabled(mod, enabled)
+
+		err := f.ficsitCli.Profiles.Save()
+		if err != nil {
+			l.Error("failed to save profile", slog.Any("error", err))
+		}
+
+		installErr := f.apply(l, taskUpdates)
+
+		if installErr != nil {
+			l.Error("failed to install", slog.Any("error", installErr))
+			return installErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return enabled, nil
+}
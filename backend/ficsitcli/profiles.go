@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"sort"
 	"time"
 
 	"github.com/satisfactorymodding/ficsit-cli/cli"
 	resolver "github.com/satisfactorymodding/ficsit-resolver"
+	"github.com/spf13/viper"
 	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
 
 	appCommon "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
@@ -117,11 +119,18 @@ func (f *ficsitCLI) AddProfile(name string) error {
 		l.Error("failed to save profile", slog.Any("error", err))
 	}
 
+	setProfileCreationDate(name)
+
 	f.EmitGlobals()
 
 	return nil
 }
 
+func setProfileCreationDate(name string) {
+	settings.Settings.ProfileCreationDates[name] = time.Now()
+	_ = settings.SaveSettings()
+}
+
 func (f *ficsitCLI) RenameProfile(oldName string, newName string) error {
 	l := slog.With(slog.String("task", "renameProfile"), slog.String("oldName", oldName), slog.String("newName", newName))
 
@@ -142,6 +151,13 @@ func (f *ficsitCLI) RenameProfile(oldName string, newName string) error {
 		l.Error("failed to save installations", slog.Any("error", err))
 	}
 
+	if createdAt, ok := settings.Settings.ProfileCreationDates[oldName]; ok {
+		delete(settings.Settings.ProfileCreationDates, oldName)
+		settings.Settings.ProfileCreationDates[newName] = createdAt
+		_ = settings.SaveSettings()
+	}
+	renameProfileModInstallDates(oldName, newName)
+
 	f.EmitGlobals()
 
 	return nil
@@ -175,11 +191,105 @@ func (f *ficsitCLI) DeleteProfile(name string) error {
 		l.Error("failed to save installations", slog.Any("error", err))
 	}
 
+	if _, ok := settings.Settings.ProfileCreationDates[name]; ok {
+		delete(settings.Settings.ProfileCreationDates, name)
+		_ = settings.SaveSettings()
+	}
+	forgetProfileModInstallDates(name)
+
 	f.EmitGlobals()
 
 	return nil
 }
 
+// ficsit-cli stores every profile in one profiles file, so the schema version is that of the file.
+// InitialProfilesVersion is the only version ficsit-cli has so far.
+const currentProfilesSchemaVersion = int(cli.InitialProfilesVersion)
+
+func profilesFilePath() string {
+	return filepath.Join(viper.GetString("local-dir"), viper.GetString("profiles-file"))
+}
+
+// GetProfileSchemaVersion reads the schema version of the profiles file the profile is stored in,
+// without deserializing the profiles themselves
+func (f *ficsitCLI) GetProfileSchemaVersion(profileName string) (int, error) {
+	data, err := os.ReadFile(profilesFilePath())
+	if err != nil {
+		return 0, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+	var profilesFile struct {
+		Profiles map[string]json.RawMessage `json:"profiles"`
+		Version  int                        `json:"version"`
+	}
+	err = json.Unmarshal(data, &profilesFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse profiles file: %w", err)
+	}
+	if _, ok := profilesFile.Profiles[profileName]; !ok {
+		return 0, fmt.Errorf("profile %s not found", profileName)
+	}
+	return profilesFile.Version, nil
+}
+
+// MigrateProfile saves the profile in the current schema, returning whether the stored profile was outdated.
+// ficsit-cli upgrades the profiles when loading them, so saving them is all the migration needed.
+func (f *ficsitCLI) MigrateProfile(profileName string) (bool, error) {
+	version, err := f.GetProfileSchemaVersion(profileName)
+	if err != nil {
+		return false, err
+	}
+	if version > currentProfilesSchemaVersion {
+		return false, fmt.Errorf("profile %s uses schema version %d, newer than the supported %d", profileName, version, currentProfilesSchemaVersion)
+	}
+	if version == currentProfilesSchemaVersion {
+		return false, nil
+	}
+
+	err = f.ficsitCli.Profiles.Save()
+	if err != nil {
+		return false, fmt.Errorf("failed to save profiles: %w", err)
+	}
+	return true, nil
+}
+
+// ReplaceProfileMods sets the mods of the profile, creating it if it does not exist.
+// If the selected installation uses the profile, the new mods are applied.
+func (f *ficsitCLI) ReplaceProfileMods(name string, mods map[string]cli.ProfileMod) error {
+	return f.action(ActionImportProfile, newSimpleItem(name), func(l *slog.Logger, taskChannel chan<- taskUpdate) error {
+		profile := f.GetProfile(name)
+		if profile == nil {
+			var err error
+			profile, err = f.ficsitCli.Profiles.AddProfile(name)
+			if err != nil {
+				l.Error("failed to add profile", slog.Any("error", err))
+				return fmt.Errorf("failed to add profile: %s: %w", name, err)
+			}
+			setProfileCreationDate(name)
+		}
+
+		profile.Mods = mods
+
+		err := f.ficsitCli.Profiles.Save()
+		if err != nil {
+			l.Error("failed to save profile", slog.Any("error", err))
+		}
+
+		f.EmitGlobals()
+
+		selectedInstallation := f.GetSelectedInstall()
+		if selectedInstallation == nil || selectedInstallation.Profile != name {
+			return nil
+		}
+
+		installErr := f.apply(l, taskChannel)
+		if installErr != nil {
+			l.Error("failed to validate installation", slog.Any("error", installErr))
+			return installErr
+		}
+		return nil
+	})
+}
+
 type ExportedProfile struct {
 	Profile  cli.Profile              `json:"profile"`
 	LockFile resolver.LockFile        `json:"lockfile"`
@@ -357,6 +467,8 @@ func (f *ficsitCLI) ImportProfile(name string, file string) error {
 			l.Error("failed to save profile", slog.Any("error", err))
 		}
 
+		setProfileCreationDate(name)
+
 		return nil
 	})
 }
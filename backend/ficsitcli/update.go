@@ -124,3 +124,79 @@ func (f *ficsitCLI) UpdateMods(mods []string) error {
 		return nil
 	})
 }
+
+// ResolveSelectedProfileWith resolves the selected profile with the given mods added or changed, without saving anything.
+// The current lockfile is used, so the mods that do not need to change keep their installed versions.
+func (f *ficsitCLI) ResolveSelectedProfileWith(mods map[string]string) (*resolver.LockFile, error) {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return nil, fmt.Errorf("no installation selected")
+	}
+
+	currentLockfile, err := selectedInstallation.LockFile(f.ficsitCli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current lockfile: %w", err)
+	}
+
+	profile := f.GetProfile(selectedInstallation.Profile)
+	if profile == nil {
+		return nil, fmt.Errorf("profile %s not found", selectedInstallation.Profile)
+	}
+	toResolve := make(map[string]cli.ProfileMod, len(profile.Mods)+len(mods))
+	for modReference, modData := range profile.Mods {
+		toResolve[modReference] = modData
+	}
+	for modReference, version := range mods {
+		toResolve[modReference] = cli.ProfileMod{
+			Enabled: true,
+			Version: version,
+		}
+	}
+	return f.resolveForSelectedInstall(toResolve, currentLockfile)
+}
+
+// ResolveModsForSelectedInstall resolves only the given mods and their dependencies for the selected installation's game version
+func (f *ficsitCLI) ResolveModsForSelectedInstall(mods map[string]string) (*resolver.LockFile, error) {
+	toResolve := make(map[string]cli.ProfileMod, len(mods))
+	for modReference, version := range mods {
+		toResolve[modReference] = cli.ProfileMod{
+			Enabled: true,
+			Version: version,
+		}
+	}
+	return f.resolveForSelectedInstall(toResolve, nil)
+}
+
+func (f *ficsitCLI) resolveForSelectedInstall(mods map[string]cli.ProfileMod, lockfile *resolver.LockFile) (*resolver.LockFile, error) {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return nil, fmt.Errorf("no installation selected")
+	}
+
+	gameVersion, err := selectedInstallation.GetGameVersion(f.ficsitCli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game version: %w", err)
+	}
+
+	profile := f.GetProfile(selectedInstallation.Profile)
+	if profile == nil {
+		return nil, fmt.Errorf("profile %s not found", selectedInstallation.Profile)
+	}
+
+	planProfile := &cli.Profile{
+		Name:            "Plan temp",
+		Mods:            mods,
+		RequiredTargets: profile.RequiredTargets,
+	}
+
+	res := resolver.NewDependencyResolver(f.ficsitCli.Provider)
+	newLockfile, err := planProfile.Resolve(res, lockfile, gameVersion)
+	if err != nil {
+		var solvingError resolver.DependencyResolverError
+		if errors.As(err, &solvingError) {
+			return nil, solvingError
+		}
+		return nil, err //nolint:wrapcheck
+	}
+	return newLockfile, nil
+}
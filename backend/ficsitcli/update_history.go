@@ -0,0 +1,158 @@
+package ficsitcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	resolver "github.com/satisfactorymodding/ficsit-resolver"
+	"github.com/spf13/viper"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
+)
+
+type UpdateEventType string
+
+var (
+	UpdateEventInstall UpdateEventType = "install"
+	UpdateEventUpdate  UpdateEventType = "update"
+	UpdateEventRemove  UpdateEventType = "remove"
+)
+
+// UpdateEvent is a mod change made by SMM. The installation path is redacted, since the paths of remote installations contain credentials
+type UpdateEvent struct {
+	Timestamp    time.Time       `json:"timestamp"`
+	Type         UpdateEventType `json:"type"`
+	ModID        string          `json:"modId"`
+	FromVersion  string          `json:"fromVersion,omitempty"`
+	ToVersion    string          `json:"toVersion,omitempty"`
+	Profile      string          `json:"profile"`
+	Installation string          `json:"installation"`
+}
+
+var updateHistory = sync.OnceValue(func() *utils.JSONLinesLog[UpdateEvent] {
+	return utils.NewJSONLinesLog[UpdateEvent](viper.GetString("update-history-file"), 1)
+})
+
+type lockfileSnapshot struct {
+	installation string
+	profile      string
+	mods         map[string]resolver.LockedMod
+}
+
+func (f *ficsitCLI) snapshotLockfile() *lockfileSnapshot {
+	selectedInstallation := f.GetSelectedInstall()
+	if selectedInstallation == nil {
+		return nil
+	}
+	mods, err := f.GetSelectedInstallLockfileMods()
+	if err != nil {
+		return nil
+	}
+	return &lockfileSnapshot{
+		installation: selectedInstallation.Path,
+		profile:      selectedInstallation.Profile,
+		mods:         mods,
+	}
+}
+
+// recordLockfileChanges writes the mod changes made to the selected installation since the snapshot.
+// Switching the installation or profile is not a change of mods, so nothing is recorded then.
+func (f *ficsitCLI) recordLockfileChanges(before *lockfileSnapshot) {
+	after := f.snapshotLockfile()
+	if before == nil || after == nil || before.installation != after.installation || before.profile != after.profile {
+		return
+	}
+
+	now := time.Now()
+	installation := utils.RedactPath(after.installation)
+	events := make([]UpdateEvent, 0)
+	for modID, newMod := range after.mods {
+		event := UpdateEvent{
+			Timestamp:    now,
+			ModID:        modID,
+			ToVersion:    newMod.Version,
+			Profile:      after.profile,
+			Installation: installation,
+		}
+		oldMod, ok := before.mods[modID]
+		switch {
+		case !ok:
+			event.Type = UpdateEventInstall
+		case oldMod.Version != newMod.Version:
+			event.Type = UpdateEventUpdate
+			event.FromVersion = oldMod.Version
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+	for modID, oldMod := range before.mods {
+		if _, ok := after.mods[modID]; !ok {
+			events = append(events, UpdateEvent{
+				Timestamp:    now,
+				Type:         UpdateEventRemove,
+				ModID:        modID,
+				FromVersion:  oldMod.Version,
+				Profile:      after.profile,
+				Installation: installation,
+			})
+		}
+	}
+	slices.SortFunc(events, func(a, b UpdateEvent) int {
+		return strings.Compare(a.ModID, b.ModID)
+	})
+
+	for _, event := range events {
+		err := updateHistory().Append(event)
+		if err != nil {
+			slog.Error("failed to record mod change", slog.String("mod", event.ModID), slog.Any("error", err))
+			return
+		}
+	}
+}
+
+// ReadUpdateHistory returns the recorded mod changes, most recent first
+func ReadUpdateHistory() ([]UpdateEvent, error) {
+	events, err := updateHistory().ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		// Entries written before the paths were redacted have the full path
+		events[i].Installation = utils.RedactPath(events[i].Installation)
+	}
+	slices.Reverse(events)
+	return events, nil
+}
+
+// ReadUpdateHistoryLines returns the recorded mod changes that match as they are stored, one JSON object per line, most recent first
+func ReadUpdateHistoryLines(match func(UpdateEvent) bool) ([]string, error) {
+	lines, err := updateHistory().ReadMatchingLines(match)
+	if err != nil {
+		return nil, err
+	}
+	for i, line := range lines {
+		// Entries written before the paths were redacted have the full path, so those are re-serialized redacted
+		var event UpdateEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			continue
+		}
+		redacted := utils.RedactPath(event.Installation)
+		if redacted == event.Installation {
+			continue
+		}
+		event.Installation = redacted
+		data, err := utils.JSONMarshal(event, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize update history entry: %w", err)
+		}
+		lines[i] = strings.TrimSuffix(string(data), "\n")
+	}
+	slices.Reverse(lines)
+	return lines, nil
+}
@@ -5,25 +5,29 @@ import (
 	"log/slog"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mitchellh/go-ps"
 	"github.com/puzpuzpuz/xsync/v3"
 	"github.com/satisfactorymodding/ficsit-cli/cli"
 	"github.com/satisfactorymodding/ficsit-cli/cli/provider"
-	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
 
 	appCommon "github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/installfinders/common"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/settings"
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
 )
 
 type ficsitCLI struct {
 	ficsitCli            *cli.GlobalContext
 	installationMetadata *xsync.MapOf[string, installationMetadata]
 	installFindErrors    []error
-	isGameRunning        bool
+	isGameRunning        atomic.Bool
 	actionMutex          sync.Mutex
+	runningAction        atomic.Pointer[runningAction]
+	// ModsChanged is dispatched after the mods of the selected profile or installation change
+	ModsChanged utils.EventDispatcher[interface{}]
 }
 
 var FicsitCLI *ficsitCLI
@@ -75,24 +79,41 @@ var executableNames = []string{
 func (f *ficsitCLI) StartGameRunningWatcher() {
 	gameRunningTicker := time.NewTicker(5 * time.Second)
 	go func() {
+		var currentLaunch *LaunchRecord
 		for range gameRunningTicker.C {
 			processes, err := ps.Processes()
 			if err != nil {
 				slog.Error("failed to get processes", slog.Any("error", err))
 				continue
 			}
-			f.isGameRunning = false
+			isGameRunning := false
 			for _, process := range processes {
 				if slices.Contains(executableNames, process.Executable()) {
-					f.isGameRunning = true
+					isGameRunning = true
 					break
 				}
 			}
-			wailsRuntime.EventsEmit(appCommon.AppContext, "isGameRunning", f.isGameRunning)
+			f.isGameRunning.Store(isGameRunning)
+			if isGameRunning && currentLaunch == nil {
+				record := f.newLaunchRecord()
+				currentLaunch = &record
+			} else if !isGameRunning && currentLaunch != nil {
+				currentLaunch.ExitTime = time.Now()
+				err := writeLaunchRecord(*currentLaunch)
+				if err != nil {
+					slog.Error("failed to record game launch", slog.Any("error", err))
+				}
+				currentLaunch = nil
+			}
+			appCommon.EmitEvent(appCommon.AppContext, "isGameRunning", isGameRunning)
 		}
 	}()
 }
 
+func (f *ficsitCLI) IsGameRunning() bool {
+	return f.isGameRunning.Load()
+}
+
 // GetProgress exists only to ensure the Progress type is exported to typescript. It returns nil
 func (f *ficsitCLI) GetProgress() *Progress {
 	return nil
@@ -104,8 +125,9 @@ func (f *ficsitCLI) EmitModsChange() {
 		slog.Error("failed to load lockfile", slog.Any("error", err))
 		return
 	}
-	wailsRuntime.EventsEmit(appCommon.AppContext, "lockfileMods", lockfileMods)
-	wailsRuntime.EventsEmit(appCommon.AppContext, "manifestMods", f.GetSelectedInstallProfileMods())
+	appCommon.EmitEvent(appCommon.AppContext, "lockfileMods", lockfileMods)
+	appCommon.EmitEvent(appCommon.AppContext, "manifestMods", f.GetSelectedInstallProfileMods())
+	f.ModsChanged.Dispatch(nil)
 }
 
 func (f *ficsitCLI) EmitGlobals() {
@@ -115,14 +137,14 @@ func (f *ficsitCLI) EmitGlobals() {
 		// We can safely ignore this call.
 		return
 	}
-	wailsRuntime.EventsEmit(appCommon.AppContext, "installations", f.GetInstallations())
-	wailsRuntime.EventsEmit(appCommon.AppContext, "installationsMetadata", f.GetInstallationsMetadata())
-	wailsRuntime.EventsEmit(appCommon.AppContext, "remoteServers", f.GetRemoteInstallations())
+	appCommon.EmitEvent(appCommon.AppContext, "installations", f.GetInstallations())
+	appCommon.EmitEvent(appCommon.AppContext, "installationsMetadata", f.GetInstallationsMetadata())
+	appCommon.EmitEvent(appCommon.AppContext, "remoteServers", f.GetRemoteInstallations())
 	profileNames := make([]string, 0, len(f.ficsitCli.Profiles.Profiles))
 	for k := range f.ficsitCli.Profiles.Profiles {
 		profileNames = append(profileNames, k)
 	}
-	wailsRuntime.EventsEmit(appCommon.AppContext, "profiles", profileNames)
+	appCommon.EmitEvent(appCommon.AppContext, "profiles", profileNames)
 
 	selectedInstallation := f.GetSelectedInstall()
 
@@ -130,10 +152,10 @@ func (f *ficsitCLI) EmitGlobals() {
 		return
 	}
 
-	wailsRuntime.EventsEmit(appCommon.AppContext, "selectedInstallation", selectedInstallation.Path)
-	wailsRuntime.EventsEmit(appCommon.AppContext, "selectedProfile", selectedInstallation.Profile)
-	wailsRuntime.EventsEmit(appCommon.AppContext, "modsEnabled", !selectedInstallation.Vanilla)
-	wailsRuntime.EventsEmit(appCommon.AppContext, "selectedProfileTargets", f.SelectedProfileTargets())
+	appCommon.EmitEvent(appCommon.AppContext, "selectedInstallation", selectedInstallation.Path)
+	appCommon.EmitEvent(appCommon.AppContext, "selectedProfile", selectedInstallation.Profile)
+	appCommon.EmitEvent(appCommon.AppContext, "modsEnabled", !selectedInstallation.Vanilla)
+	appCommon.EmitEvent(appCommon.AppContext, "selectedProfileTargets", f.SelectedProfileTargets())
 }
 
 func (f *ficsitCLI) SelectedProfileTargets() map[string][]string {
@@ -107,3 +107,19 @@ func getGameSavedDir(gamePath string, install InstallType, platform Platform) st
 	}
 	return filepath.Join(gamePath, "FactoryGame", "Saved")
 }
+
+// GetEngineVersion returns the Major.Minor.Patch Unreal Engine version the game at path was built with
+func GetEngineVersion(path string) (string, error) {
+	for _, info := range gameInfo {
+		versionFile, err := os.ReadFile(filepath.Join(path, info.versionPath))
+		if err != nil {
+			continue
+		}
+		var versionData GameVersionFile
+		if err := json.Unmarshal(versionFile, &versionData); err != nil {
+			return "", fmt.Errorf("failed to parse version file %s: %w", info.versionPath, err)
+		}
+		return fmt.Sprintf("%d.%d.%d", versionData.MajorVersion, versionData.MinorVersion, versionData.PatchVersion), nil
+	}
+	return "", fmt.Errorf("failed to get engine version")
+}
@@ -22,6 +22,14 @@ var (
 	LocationTypeRemote LocationType = "Remote"
 )
 
+// Store is the store the game was bought from, for installations found through a store's launcher
+type Store string
+
+var (
+	StoreSteam Store = "Steam"
+	StoreEpic  Store = "Epic"
+)
+
 type Installation struct {
 	Path       string       `json:"path"`
 	Version    int          `json:"version"`
@@ -30,6 +38,8 @@ type Installation struct {
 	Branch     GameBranch   `json:"branch"`
 	Launcher   string       `json:"launcher"`
 	LaunchPath []string     `json:"launchPath"`
+	Store      Store        `json:"store"`
+	StoreID    string       `json:"storeId"`
 	SavedPath  string       `json:"-"`
 }
 
@@ -72,3 +82,11 @@ var AllLocationTypes = []struct {
 	{LocationTypeLocal, "LOCAL"},
 	{LocationTypeRemote, "REMOTE"},
 }
+
+var AllStores = []struct {
+	Value  Store
+	TSName string
+}{
+	{StoreSteam, "STEAM"},
+	{StoreEpic, "EPIC"},
+}
@@ -151,6 +151,8 @@ func FindInstallationsEpic(epicManifestsPath string, launcher string, platform c
 			Branch:     branch,
 			Launcher:   launcher,
 			LaunchPath: platform.LauncherCommand(epicManifest.MainGameAppName),
+			Store:      common.StoreEpic,
+			StoreID:    epicManifest.MainGameAppName,
 			SavedPath:  savedPath,
 		})
 	}
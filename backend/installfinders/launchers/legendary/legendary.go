@@ -96,6 +96,8 @@ func FindInstallationsIn(legendaryDataPath string, launcher string, knownPrefixe
 			Branch:     branch,
 			Launcher:   launcher,
 			LaunchPath: platform.LauncherCommand(legendaryGame.AppName),
+			Store:      common.StoreEpic,
+			StoreID:    legendaryGame.AppName,
 			SavedPath:  savedPath,
 		})
 	}
@@ -93,6 +93,12 @@ func FindInstallationsSteam(steamPath string, launcher string, platform common.L
 
 			appState := manifest["AppState"].(map[string]interface{})
 
+			appID, ok := appState["appid"].(string)
+			if !ok {
+				findErrors = append(findErrors, fmt.Errorf("failed to find appid in manifest %s", manifestPath))
+				continue
+			}
+
 			fullInstallationPath := platform.ProcessPath(filepath.Join(libraryFolder, "steamapps", "common", appState["installdir"].(string)))
 
 			gamePlatform := platform.Platform
@@ -100,10 +106,10 @@ func FindInstallationsSteam(steamPath string, launcher string, platform common.L
 				// The game might be running under Proton
 				// There's no appmanifest field that would specify it, but if the proton prefix exists,
 				// the game is most likely running under Proton.
-				gameProtonPrefix := platform.ProcessPath(filepath.Join(steamPath, "steamapps", "compatdata", appState["appid"].(string), "pfx"))
+				gameProtonPrefix := platform.ProcessPath(filepath.Join(steamPath, "steamapps", "compatdata", appID, "pfx"))
 				_, err := os.Stat(gameProtonPrefix)
 				if err != nil && !os.IsNotExist(err) {
-					findErrors = append(findErrors, fmt.Errorf("failed to find proton prefix for game %s: %w", appState["appid"].(string), err))
+					findErrors = append(findErrors, fmt.Errorf("failed to find proton prefix for game %s: %w", appID, err))
 					continue
 				}
 				if err == nil {
@@ -141,6 +147,8 @@ func FindInstallationsSteam(steamPath string, launcher string, platform common.L
 				Branch:     branch,
 				Launcher:   launcher,
 				LaunchPath: platform.LauncherCommand(`steam://rungameid/526870`),
+				Store:      common.StoreSteam,
+				StoreID:    appID,
 				// pass wine platform if necessary, as platform here is going to be native
 				SavedPath: savedPath,
 			})
@@ -58,3 +58,21 @@ func (m *migration) MarkSmm2MigrationSuccess() error {
 	}
 	return nil
 }
+
+// GetSmm2Profiles returns the names of the SMM2 profiles, which ficsit-cli imports when it has no profiles yet
+func (m *migration) GetSmm2Profiles() []string {
+	entries, err := os.ReadDir(m.smm2Dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			slog.Warn("failed to read SMM2 profiles", slog.Any("error", err))
+		}
+		return []string{}
+	}
+	profiles := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() && pathExists(filepath.Join(m.smm2Dir, entry.Name(), "manifest.json")) {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+	return profiles
+}
@@ -0,0 +1,79 @@
+package notifications
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
+)
+
+type AppNotification struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Timestamp time.Time `json:"timestamp"`
+	Dismissed bool      `json:"dismissed"`
+}
+
+// Notifications are only kept for the current session
+const maxNotifications = 50
+
+var ErrNotificationNotFound = fmt.Errorf("notification not found")
+
+var (
+	history   []AppNotification
+	historyMu sync.Mutex
+	nextID    int
+)
+
+// Push records a notification so it can still be seen after the event that caused it was missed
+func Push(notificationType, title, body string) {
+	historyMu.Lock()
+	nextID++
+	notification := AppNotification{
+		ID:        strconv.Itoa(nextID),
+		Type:      notificationType,
+		Title:     title,
+		Body:      body,
+		Timestamp: time.Now(),
+	}
+	history = append(history, notification)
+	if len(history) > maxNotifications {
+		history = slices.Delete(history, 0, len(history)-maxNotifications)
+	}
+	historyMu.Unlock()
+
+	if common.AppContext != nil {
+		common.EmitEvent(common.AppContext, "notification", notification)
+	}
+}
+
+func History() []AppNotification {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	return slices.Clone(history)
+}
+
+func Dismiss(id string) error {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	for i := range history {
+		if history[i].ID == id {
+			history[i].Dismissed = true
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotificationNotFound, id)
+}
+
+func DismissAll() {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	for i := range history {
+		history[i].Dismissed = true
+	}
+}
@@ -0,0 +1,51 @@
+package settings
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// matchesDomain checks if the host is the domain or one of its subdomains
+func matchesDomain(host, domain string) bool {
+	host = strings.ToLower(host)
+	domain = strings.ToLower(strings.TrimPrefix(domain, "."))
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// proxyForRequest picks the most specific proxy rule for the request:
+// a per-domain proxy, then the no proxy list, then the default proxy, and finally the system proxy
+func proxyForRequest(request *http.Request) (*url.URL, error) {
+	host := request.URL.Hostname()
+
+	// Prefer the longest matching domain, so subdomain rules override their parent domain's
+	bestDomain := ""
+	for domain := range Settings.PerDomainProxies {
+		if matchesDomain(host, domain) && len(domain) > len(bestDomain) {
+			bestDomain = domain
+		}
+	}
+	if bestDomain != "" {
+		return url.Parse(Settings.PerDomainProxies[bestDomain]) //nolint:wrapcheck
+	}
+
+	for _, domain := range Settings.NoProxyList {
+		if matchesDomain(host, domain) {
+			return nil, nil
+		}
+	}
+
+	if Settings.Proxy != "" {
+		return url.Parse(Settings.Proxy) //nolint:wrapcheck
+	}
+
+	return http.ProxyFromEnvironment(request) //nolint:wrapcheck
+}
+
+// ApplyProxy makes the requests from the backend use the proxy settings.
+// The settings are read for every request, so changes apply without a restart.
+func ApplyProxy() {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport.Proxy = proxyForRequest
+	}
+}
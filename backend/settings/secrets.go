@@ -0,0 +1,121 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Secrets are kept in the OS credential store instead of settings.json.
+// If the credential store cannot be used, they are only kept in memory for the session.
+const keyringService = "SatisfactoryModManager"
+
+const (
+	cloudSyncCredentialsKey = "cloudSyncCredentials"
+	gitHubTokenKey          = "githubToken"
+)
+
+var (
+	secrets     = map[string]string{}
+	secretsLock sync.Mutex
+)
+
+func getSecret(key string) string {
+	secretsLock.Lock()
+	defer secretsLock.Unlock()
+	if value, ok := secrets[key]; ok {
+		return value
+	}
+	value, err := keyring.Get(keyringService, key)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			slog.Warn("failed to read from the credential store", slog.String("key", key), slog.Any("error", err))
+		}
+		value = ""
+	}
+	secrets[key] = value
+	return value
+}
+
+func setSecret(key, value string) error {
+	secretsLock.Lock()
+	defer secretsLock.Unlock()
+	secrets[key] = value
+	var err error
+	if value == "" {
+		err = keyring.Delete(keyringService, key)
+		if errors.Is(err, keyring.ErrNotFound) {
+			err = nil
+		}
+	} else {
+		err = keyring.Set(keyringService, key, value)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write to the credential store: %w", err)
+	}
+	return nil
+}
+
+func GetCloudSyncCredentials() CloudSyncCredentials {
+	var credentials CloudSyncCredentials
+	value := getSecret(cloudSyncCredentialsKey)
+	if value == "" {
+		return credentials
+	}
+	if err := json.Unmarshal([]byte(value), &credentials); err != nil {
+		slog.Warn("failed to parse the stored cloud sync credentials", slog.Any("error", err))
+	}
+	return credentials
+}
+
+func storeCloudSyncCredentials(credentials CloudSyncCredentials) error {
+	if credentials == (CloudSyncCredentials{}) {
+		return setSecret(cloudSyncCredentialsKey, "")
+	}
+	value, err := json.Marshal(credentials)
+	if err != nil {
+		return fmt.Errorf("failed to serialize cloud sync credentials: %w", err)
+	}
+	return setSecret(cloudSyncCredentialsKey, string(value))
+}
+
+// GetGitHubToken returns the personal access token used for the GitHub API requests, or empty if none is set
+func GetGitHubToken() string {
+	return getSecret(gitHubTokenKey)
+}
+
+// migrateSecrets moves the secrets older versions stored in settings.json to the credential store.
+// If that fails, they are left in settings.json so they are not lost.
+func migrateSecrets() {
+	migrated := false
+	if Settings.LegacyCloudSyncCredentials != nil {
+		var err error
+		if *Settings.LegacyCloudSyncCredentials != (CloudSyncCredentials{}) {
+			err = storeCloudSyncCredentials(*Settings.LegacyCloudSyncCredentials)
+		}
+		if err != nil {
+			slog.Warn("failed to move cloud sync credentials to the credential store", slog.Any("error", err))
+		} else {
+			Settings.LegacyCloudSyncCredentials = nil
+			migrated = true
+		}
+	}
+	if Settings.LegacyGitHubToken != "" {
+		if err := setSecret(gitHubTokenKey, Settings.LegacyGitHubToken); err != nil {
+			slog.Warn("failed to move the GitHub token to the credential store", slog.Any("error", err))
+		} else {
+			Settings.LegacyGitHubToken = ""
+			migrated = true
+		}
+	}
+	if !migrated {
+		return
+	}
+	if err := SaveSettings(); err != nil {
+		slog.Error("failed to save settings", slog.Any("error", err))
+	}
+}
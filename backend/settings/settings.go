@@ -6,10 +6,11 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	psUtilDisk "github.com/shirou/gopsutil/v3/disk"
 	"github.com/spf13/viper"
-	wailsRuntime "github.com/wailsapp/wails/v2/pkg/runtime"
 
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/common"
 	"github.com/satisfactorymodding/SatisfactoryModManager/backend/utils"
@@ -35,6 +36,49 @@ var (
 	UpdateAsk      UpdateCheckMode = "ask"
 )
 
+type StartupBehavior struct {
+	CheckForManagerUpdates bool `json:"checkForManagerUpdates"`
+	// CheckForModUpdates is off by default, so existing users do not start getting update checks on startup
+	CheckForModUpdates bool `json:"checkForModUpdates"`
+	// AutoSwitchToLastProfile keeps the profile selected in the previous session, instead of switching to the default one
+	AutoSwitchToLastProfile bool `json:"autoSwitchToLastProfile"`
+	StartMinimized          bool `json:"startMinimized"`
+}
+
+type CloudSyncCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// CloudSyncProfileState is what the profile looked like the last time it was synced
+type CloudSyncProfileState struct {
+	LastSyncedAt time.Time `json:"lastSyncedAt"`
+	ETag         string    `json:"etag"`
+	ContentHash  string    `json:"contentHash"`
+}
+
+type AutoUpdateSettings struct {
+	UpdateSMLAutomatically     bool `json:"updateSMLAutomatically"`
+	UpdateModsAutomatically    bool `json:"updateModsAutomatically"`
+	UpdateManagerAutomatically bool `json:"updateManagerAutomatically"`
+	// The window is the time of day automatic updates can run in, as an offset from midnight in local time.
+	// The window can wrap around midnight, and if start and end are equal, updates can run at any time
+	AutoUpdateWindowStart time.Duration `json:"autoUpdateWindowStart"`
+	AutoUpdateWindowEnd   time.Duration `json:"autoUpdateWindowEnd"`
+}
+
+func (a AutoUpdateSettings) InWindow(t time.Time) bool {
+	if a.AutoUpdateWindowStart == a.AutoUpdateWindowEnd {
+		return true
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+	if a.AutoUpdateWindowStart < a.AutoUpdateWindowEnd {
+		return offset >= a.AutoUpdateWindowStart && offset < a.AutoUpdateWindowEnd
+	}
+	return offset >= a.AutoUpdateWindowStart || offset < a.AutoUpdateWindowEnd
+}
+
 type settings struct {
 	WindowPosition *utils.Position `json:"windowPosition,omitempty"`
 	Maximized      bool            `json:"maximized,omitempty"`
@@ -42,32 +86,74 @@ type settings struct {
 	UnexpandedSize utils.Size `json:"unexpandedSize,omitempty"`
 	ExpandedSize   utils.Size `json:"expandedSize,omitempty"`
 
-	StartView View `json:"startView,omitempty"`
+	StartView       View            `json:"startView,omitempty"`
+	StartupBehavior StartupBehavior `json:"startupBehavior"`
 
 	FavoriteMods []string        `json:"favoriteMods,omitempty"`
+	HiddenMods   []string        `json:"hiddenMods,omitempty"`
 	ModFilters   SavedModFilters `json:"modFilters,omitempty"`
 
+	InstalledModSortOrder string `json:"installedModSortOrder,omitempty"`
+	// ficsit-cli profiles do not record when a mod was added, so SMM tracks it for the mods it installs.
+	// Keyed by the hashed installation path, then the profile name, then the mod reference
+	ModInstallDates map[string]map[string]map[string]time.Time `json:"modInstallDatesByInstall,omitempty"`
+	// LastModBrowseTime is when the newly published mods were last checked
+	LastModBrowseTime time.Time `json:"lastModBrowseTime,omitempty"`
+
 	RemoteNames map[string]string `json:"remoteNames,omitempty"`
 
+	QuickLaunchProfiles []string `json:"quickLaunchProfiles,omitempty"`
+	// ficsit-cli profiles have no creation date, so SMM tracks it for the profiles it creates
+	ProfileCreationDates map[string]time.Time `json:"profileCreationDates,omitempty"`
+
 	QueueAutoStart      bool                `json:"queueAutoStart"`
 	IgnoredUpdates      map[string][]string `json:"ignoredUpdates,omitempty"`
 	UpdateCheckMode     UpdateCheckMode     `json:"updateCheckMode,omitempty"`
+	AutoUpdate          AutoUpdateSettings  `json:"autoUpdate"`
 	ViewedAnnouncements []string            `json:"viewedAnnouncements,omitempty"`
 
+	PreferStableReleases bool `json:"preferStableReleases,omitempty"`
+
+	NewsFeedURL  string   `json:"newsFeedUrl,omitempty"`
+	ReadNewsURLs []string `json:"readNewsUrls,omitempty"`
+
+	// CloudSyncEndpoint is the WebDAV collection profiles are synced to
+	CloudSyncEndpoint string                           `json:"cloudSyncEndpoint,omitempty"`
+	CloudSyncState    map[string]CloudSyncProfileState `json:"cloudSyncState,omitempty"`
+	// LegacyCloudSyncCredentials are only read, to move them to the credential store, see migrateSecrets
+	LegacyCloudSyncCredentials *CloudSyncCredentials `json:"cloudSyncCredentials,omitempty"`
+
+	// LegacyGitHubToken is only read, to move it to the credential store, see migrateSecrets
+	LegacyGitHubToken string `json:"githubToken,omitempty"`
+
 	Offline bool `json:"offline,omitempty"`
 
 	Language string `json:"language,omitempty"`
 
 	Proxy string `json:"proxy,omitempty"`
 
+	// PerDomainProxies are keyed by domain, which also matches its subdomains
+	PerDomainProxies map[string]string `json:"perDomainProxies,omitempty"`
+	NoProxyList      []string          `json:"noProxyList,omitempty"`
+
 	Konami       bool   `json:"konami,omitempty"`
 	LaunchButton string `json:"launchButton,omitempty"`
 
 	CacheDir string `json:"cacheDir,omitempty"`
+	// MaxCacheSize is the size in bytes the download cache is kept under
+	MaxCacheSize int64 `json:"maxCacheSize,omitempty"`
 
 	Debug bool `json:"debug,omitempty"`
+	// SMLLogLineCount is how many lines of the game log GetSMLLogOutput returns, 0 means the default
+	SMLLogLineCount int `json:"smlLogLineCount,omitempty"`
+
+	// FeatureFlagOverrides take precedence over the remote feature flags
+	FeatureFlagOverrides map[string]bool `json:"featureFlagOverrides,omitempty"`
 
 	NewUserSetupComplete bool `json:"newUserSetupComplete,omitempty"`
+
+	// LastRunVersion is the SMM version that last started with these settings, used to detect upgrades
+	LastRunVersion string `json:"lastRunVersion,omitempty"`
 }
 
 var Settings = &settings{
@@ -78,25 +164,48 @@ var Settings = &settings{
 	ExpandedSize:   utils.ExpandedDefault,
 
 	StartView: ViewCompact,
+	StartupBehavior: StartupBehavior{
+		CheckForManagerUpdates:  true,
+		CheckForModUpdates:      false,
+		AutoSwitchToLastProfile: true,
+		StartMinimized:          false,
+	},
 
 	FavoriteMods: []string{},
+	HiddenMods:   []string{},
 	ModFilters: SavedModFilters{
 		Order:  "last-updated",
 		Filter: "compatible",
 	},
 
+	InstalledModSortOrder: "name",
+	ModInstallDates:       map[string]map[string]map[string]time.Time{},
+
 	RemoteNames: map[string]string{},
 
+	QuickLaunchProfiles:  []string{},
+	ProfileCreationDates: map[string]time.Time{},
+
 	QueueAutoStart:      true,
 	IgnoredUpdates:      map[string][]string{},
 	UpdateCheckMode:     UpdateOnLaunch,
 	ViewedAnnouncements: []string{},
 
+	AutoUpdate: AutoUpdateSettings{
+		UpdateManagerAutomatically: true,
+	},
+
+	ReadNewsURLs: []string{},
+
+	CloudSyncState: map[string]CloudSyncProfileState{},
+
 	Offline: false,
 
 	Konami:       false,
 	LaunchButton: "normal",
 
+	MaxCacheSize: 5 * 1024 * 1024 * 1024,
+
 	Debug: false,
 
 	NewUserSetupComplete: false,
@@ -153,6 +262,37 @@ func (s *settings) GetFavoriteMods() []string {
 	return s.FavoriteMods
 }
 
+func (s *settings) AddHiddenMod(modReference string) error {
+	if slices.Contains(s.HiddenMods, modReference) {
+		return nil
+	}
+	s.HiddenMods = append(s.HiddenMods, modReference)
+	err := SaveSettings()
+	if err != nil {
+		return err
+	}
+	s.emitHiddenMods()
+	return nil
+}
+
+func (s *settings) RemoveHiddenMod(modReference string) error {
+	idx := slices.Index(s.HiddenMods, modReference)
+	if idx == -1 {
+		return nil
+	}
+	s.HiddenMods = slices.Delete(s.HiddenMods, idx, idx+1)
+	err := SaveSettings()
+	if err != nil {
+		return err
+	}
+	s.emitHiddenMods()
+	return nil
+}
+
+func (s *settings) emitHiddenMods() {
+	common.EmitEvent(common.AppContext, "hiddenMods", s.HiddenMods)
+}
+
 func (s *settings) GetModFiltersOrder() string {
 	return s.ModFilters.Order
 }
@@ -172,7 +312,7 @@ func (s *settings) SetModFiltersFilter(filter string) {
 }
 
 func (s *settings) emitFavoriteMods() {
-	wailsRuntime.EventsEmit(common.AppContext, "favoriteMods", s.FavoriteMods)
+	common.EmitEvent(common.AppContext, "favoriteMods", s.FavoriteMods)
 }
 
 func (s *settings) GetStartView() View {
@@ -218,7 +358,7 @@ func (s *settings) GetIgnoredUpdates() map[string][]string {
 func (s *settings) SetUpdateIgnore(modReference string, version string) {
 	s.IgnoredUpdates[modReference] = append(s.IgnoredUpdates[modReference], version)
 	_ = SaveSettings()
-	wailsRuntime.EventsEmit(common.AppContext, "ignoredUpdates", s.IgnoredUpdates)
+	common.EmitEvent(common.AppContext, "ignoredUpdates", s.IgnoredUpdates)
 }
 
 func (s *settings) SetUpdateUnignore(modReference string, version string) {
@@ -235,7 +375,7 @@ func (s *settings) SetUpdateUnignore(modReference string, version string) {
 	}
 	s.IgnoredUpdates[modReference] = append(versions[:idx], versions[idx+1:]...)
 	_ = SaveSettings()
-	wailsRuntime.EventsEmit(common.AppContext, "ignoredUpdates", s.IgnoredUpdates)
+	common.EmitEvent(common.AppContext, "ignoredUpdates", s.IgnoredUpdates)
 }
 
 func (s *settings) GetUpdateCheckMode() UpdateCheckMode {
@@ -247,6 +387,52 @@ func (s *settings) SetUpdateCheckMode(value UpdateCheckMode) {
 	_ = SaveSettings()
 }
 
+func (s *settings) GetPreferStableReleases() bool {
+	return s.PreferStableReleases
+}
+
+func (s *settings) SetPreferStableReleases(value bool) {
+	s.PreferStableReleases = value
+	_ = SaveSettings()
+}
+
+// GetNewsFeedURL returns the custom news feed URL, or an empty string if the default feed is used
+func (s *settings) GetNewsFeedURL() string {
+	return s.NewsFeedURL
+}
+
+func (s *settings) SetNewsFeedURL(value string) {
+	s.NewsFeedURL = value
+	_ = SaveSettings()
+}
+
+func (s *settings) GetCloudSyncEndpoint() string {
+	return s.CloudSyncEndpoint
+}
+
+func (s *settings) SetCloudSyncEndpoint(value string) {
+	s.CloudSyncEndpoint = value
+	// The sync state is only valid for the endpoint it was synced with
+	s.CloudSyncState = map[string]CloudSyncProfileState{}
+	_ = SaveSettings()
+}
+
+func (s *settings) SetCloudSyncCredentials(username, password string) error {
+	return storeCloudSyncCredentials(CloudSyncCredentials{
+		Username: username,
+		Password: password,
+	})
+}
+
+func (s *settings) HasGitHubToken() bool {
+	return GetGitHubToken() != ""
+}
+
+// SetGitHubToken sets the personal access token used for the GitHub API requests, an empty value removes it
+func (s *settings) SetGitHubToken(value string) error {
+	return setSecret(gitHubTokenKey, value)
+}
+
 func (s *settings) GetViewedAnnouncements() []string {
 	return s.ViewedAnnouncements
 }
@@ -264,7 +450,7 @@ func (s *settings) SetAnnouncementViewed(announcement string) {
 	}
 	s.ViewedAnnouncements = append(s.ViewedAnnouncements, announcement)
 	_ = SaveSettings()
-	wailsRuntime.EventsEmit(common.AppContext, "viewedAnnouncements", s.ViewedAnnouncements)
+	common.EmitEvent(common.AppContext, "viewedAnnouncements", s.ViewedAnnouncements)
 }
 
 func (s *settings) GetLanguage() string {
@@ -286,6 +472,19 @@ func (s *settings) SetDebug(value bool) {
 	_ = SaveSettings()
 }
 
+func (s *settings) SetFeatureFlagOverride(flag string, enabled bool) {
+	if s.FeatureFlagOverrides == nil {
+		s.FeatureFlagOverrides = make(map[string]bool)
+	}
+	s.FeatureFlagOverrides[flag] = enabled
+	_ = SaveSettings()
+}
+
+func (s *settings) ClearFeatureFlagOverride(flag string) {
+	delete(s.FeatureFlagOverrides, flag)
+	_ = SaveSettings()
+}
+
 func (s *settings) GetProxy() string {
 	return s.Proxy
 }
@@ -307,7 +506,7 @@ func (s *settings) SetCacheDir(dir string) error {
 	}
 	s.CacheDir = dir
 	_ = SaveSettings()
-	wailsRuntime.EventsEmit(common.AppContext, "cacheDir", s.GetCacheDir())
+	common.EmitEvent(common.AppContext, "cacheDir", s.GetCacheDir())
 	return nil
 }
 
@@ -431,15 +630,20 @@ func LoadSettings() error {
 
 	if err := json.Unmarshal(settingsFile, Settings); err != nil {
 		// Settings file might be SMM2 settings, try to load those
-		err = readSMM2Settings(settingsFile)
+		AppliedMigrations, err = readSMM2Settings(settingsFile)
 		if err != nil {
 			return fmt.Errorf("failed to unmarshal settings: %w", err)
 		}
 	}
 
+	migrateSecrets()
+
 	return nil
 }
 
+// AppliedMigrations are the changes made while migrating the settings file on this startup
+var AppliedMigrations []string
+
 func SaveSettings() error {
 	settingsFile, err := utils.JSONMarshal(Settings, 2)
 	if err != nil {
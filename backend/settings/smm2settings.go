@@ -45,11 +45,48 @@ type smm2Settings struct {
 
 var SMM2SelectedProfile map[string]string
 
-func readSMM2Settings(data []byte) error {
+// changes describes the SMM2 settings that are carried over
+func (s smm2Settings) changes() []string {
+	changes := make([]string, 0)
+	if s.WindowLocation != nil || s.NormalSize != nil || s.ExpandedSize != nil || s.Maximized != nil {
+		changes = append(changes, "Window position and size")
+	}
+	if s.FavoriteMods != nil {
+		changes = append(changes, "Favorite mods")
+	}
+	if s.Filters != nil {
+		changes = append(changes, "Mod list filter and sort order")
+	}
+	if s.IgnoredUpdates != nil {
+		changes = append(changes, "Ignored mod updates")
+	}
+	if s.SelectedProfile != nil {
+		changes = append(changes, "Selected profile of each installation")
+	}
+	if s.DebugMode != nil {
+		changes = append(changes, "Debug mode")
+	}
+	if s.UpdateCheckMode != nil {
+		changes = append(changes, "Update check mode")
+	}
+	if s.Konami != nil || s.LaunchButton != nil || s.LaunchCat != nil {
+		changes = append(changes, "Launch button style")
+	}
+	if s.ExpandModInfoOnStart != nil {
+		changes = append(changes, "Start view")
+	}
+	if s.ViewedAnnouncements != nil {
+		changes = append(changes, "Viewed announcements")
+	}
+	return changes
+}
+
+// readSMM2Settings loads the SMM2 settings into Settings, returning what was carried over
+func readSMM2Settings(data []byte) ([]string, error) {
 	s := smm2Settings{}
 	err := json.Unmarshal(data, &s)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal SMM2 settings: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal SMM2 settings: %w", err)
 	}
 
 	if s.WindowLocation != nil {
@@ -132,5 +169,5 @@ func readSMM2Settings(data []byte) error {
 
 	// Ignore DisableDownloadTimeout, it's not used anymore
 
-	return nil
+	return s.changes(), nil
 }
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/puzpuzpuz/xsync/v3"
+)
+
+type ttlCacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is a concurrency-safe map whose entries expire after a fixed duration.
+// A TTL of 0 means entries never expire.
+type TTLCache[K comparable, V any] struct {
+	ttl     time.Duration
+	entries *xsync.MapOf[K, ttlCacheEntry[V]]
+}
+
+func NewTTLCache[K comparable, V any](ttl time.Duration) *TTLCache[K, V] {
+	return &TTLCache[K, V]{
+		ttl:     ttl,
+		entries: xsync.NewMapOf[K, ttlCacheEntry[V]](),
+	}
+}
+
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	entry, ok := c.entries.Load(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if c.ttl != 0 && time.Now().After(entry.expiresAt) {
+		c.entries.Delete(key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *TTLCache[K, V]) Set(key K, value V) {
+	c.entries.Store(key, ttlCacheEntry[V]{
+		value:     value,
+		expiresAt: time.Now().Add(c.ttl),
+	})
+}
+
+// GetOrCompute returns the cached value for key, or calls compute and caches its result if it succeeds
+func (c *TTLCache[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+	value, err := compute()
+	if err != nil {
+		return value, err
+	}
+	c.Set(key, value)
+	return value, nil
+}
+
+func (c *TTLCache[K, V]) Delete(key K) {
+	c.entries.Delete(key)
+}
+
+func (c *TTLCache[K, V]) Clear() {
+	c.entries.Clear()
+}
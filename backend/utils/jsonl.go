@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// JSONLinesLog is an append-only log of JSON entries, one per line, rotated by size.
+// Rotated files are never removed, and they are read along with the current file, so no entries are lost.
+type JSONLinesLog[T any] struct {
+	path   string
+	writer *lumberjack.Logger
+	mutex  sync.Mutex
+}
+
+func NewJSONLinesLog[T any](path string, maxSizeMB int) *JSONLinesLog[T] {
+	return &JSONLinesLog[T]{
+		path: path,
+		writer: &lumberjack.Logger{
+			Filename: path,
+			MaxSize:  maxSizeMB,
+		},
+	}
+}
+
+func (l *JSONLinesLog[T]) Append(entry T) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	data, err := JSONMarshal(entry, 0)
+	if err != nil {
+		return fmt.Errorf("failed to serialize log entry: %w", err)
+	}
+	// The encoder already ends the entry with a newline
+	_, err = l.writer.Write(data)
+	if err != nil {
+		return fmt.Errorf("failed to write log entry: %w", err)
+	}
+	return nil
+}
+
+// ReadAll returns the entries of the log, oldest first. Invalid lines are skipped
+func (l *JSONLinesLog[T]) ReadAll() ([]T, error) {
+	entries := make([]T, 0)
+	err := l.scan(func(entry T, _ []byte) {
+		entries = append(entries, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ReadMatchingLines returns the lines of the log whose entry matches, as written, oldest first
+func (l *JSONLinesLog[T]) ReadMatchingLines(match func(T) bool) ([]string, error) {
+	lines := make([]string, 0)
+	err := l.scan(func(entry T, line []byte) {
+		if match(entry) {
+			lines = append(lines, string(line))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// files returns the rotated files of the log, oldest first, followed by the current file
+func (l *JSONLinesLog[T]) files() ([]string, error) {
+	// lumberjack names the rotated files <name>-<timestamp><ext>, so they sort by name
+	ext := filepath.Ext(l.path)
+	prefix := strings.TrimSuffix(filepath.Base(l.path), ext) + "-"
+	entries, err := os.ReadDir(filepath.Dir(l.path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read log directory: %w", err)
+	}
+	files := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), prefix) && strings.HasSuffix(entry.Name(), ext) {
+			files = append(files, filepath.Join(filepath.Dir(l.path), entry.Name()))
+		}
+	}
+	slices.Sort(files)
+	return append(files, l.path), nil
+}
+
+// scan calls f with every valid entry of the log and its line, oldest first
+func (l *JSONLinesLog[T]) scan(f func(entry T, line []byte)) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	files, err := l.files()
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		err := scanFile(path, f)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanFile[T any](path string, f func(entry T, line []byte)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to open log: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry T
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			slog.Warn("skipping invalid log entry", slog.String("path", path), slog.Any("error", err))
+			continue
+		}
+		f(entry, scanner.Bytes())
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read log: %w", err)
+	}
+	return nil
+}
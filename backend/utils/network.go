@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+type NetworkUsage struct {
+	BytesDownloaded    int64 `json:"bytesDownloaded"`
+	BytesUploaded      int64 `json:"bytesUploaded"`
+	RequestCount       int   `json:"requestCount"`
+	FailedRequestCount int   `json:"failedRequestCount"`
+}
+
+// Counted since the app started, they are never persisted
+var (
+	bytesDownloaded    atomic.Int64
+	bytesUploaded      atomic.Int64
+	requestCount       atomic.Int64
+	failedRequestCount atomic.Int64
+)
+
+func GetNetworkUsage() NetworkUsage {
+	return NetworkUsage{
+		BytesDownloaded:    bytesDownloaded.Load(),
+		BytesUploaded:      bytesUploaded.Load(),
+		RequestCount:       int(requestCount.Load()),
+		FailedRequestCount: int(failedRequestCount.Load()),
+	}
+}
+
+// NetworkUsageTransport counts the requests made through it, and the bytes sent and received
+type NetworkUsageTransport struct {
+	Inner http.RoundTripper
+}
+
+type countingReader struct {
+	inner io.ReadCloser
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.inner.Read(p)
+	bytesDownloaded.Add(int64(n))
+	return n, err //nolint:wrapcheck
+}
+
+func (r *countingReader) Close() error {
+	return r.inner.Close() //nolint:wrapcheck
+}
+
+func (t *NetworkUsageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestCount.Add(1)
+	// Bodies of unknown length are not counted
+	if req.ContentLength > 0 {
+		bytesUploaded.Add(req.ContentLength)
+	}
+	resp, err := t.Inner.RoundTrip(req)
+	if err != nil {
+		failedRequestCount.Add(1)
+		return nil, err //nolint:wrapcheck
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		failedRequestCount.Add(1)
+	}
+	if resp.Body != nil {
+		resp.Body = &countingReader{inner: resp.Body}
+	}
+	return resp, nil
+}
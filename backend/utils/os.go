@@ -83,3 +83,33 @@ func MoveRecursive(from, to string) (bool, error) {
 	}
 	return true, nil
 }
+
+// WriteFileAtomic writes data to a temporary file next to path, then renames it over path,
+// so readers never observe a partially written file
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+
+	_, err = tmpFile.Write(data)
+	if err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	err = tmpFile.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	err = os.Chmod(tmpPath, perm)
+	if err != nil {
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
@@ -28,3 +28,37 @@ const fileContent = '/* eslint-disable */\n'
 
 fs.writeFileSync(i18nFile, fileContent);
 console.log('Translations generated');
+
+// The backend embeds the language list, so it can validate the language setting.
+// The output is not tracked, builds without pulled translations use the committed backend/app/languages.json, which has no completion percentages
+const languagesManifestFile = path.join(__dirname, '../../backend/app/languages.generated.json');
+
+// flattenStrings maps each translation key path to its string
+function flattenStrings(stringTable, prefix = '') {
+  return Object.entries(stringTable).flatMap(([key, entry]) => {
+    if (entry && typeof entry === 'object') {
+      return flattenStrings(entry, `${prefix}${key}.`);
+    }
+    return [[`${prefix}${key}`, entry]];
+  });
+}
+
+const readStrings = (lang) => new Map(flattenStrings(JSON.parse(fs.readFileSync(path.join(i18nDir, `${lang}.json`), 'utf8'))));
+
+// English is the source language, so it has every key
+const sourceKeys = [...readStrings('en').keys()];
+
+const languagesManifest = langs.map((lang) => {
+  const strings = readStrings(lang);
+  const translated = sourceKeys.filter((key) => !!strings.get(key)).length;
+  return {
+    code: lang,
+    name: new Intl.DisplayNames(['en'], { type: 'language' }).of(lang) ?? lang,
+    nativeName: new Intl.DisplayNames([lang], { type: 'language' }).of(lang) ?? lang,
+    completionPercent: Math.round(translated / sourceKeys.length * 100),
+    translated,
+  };
+}).filter((lang) => lang.translated > 0).map(({ translated, ...lang }) => lang);
+
+fs.writeFileSync(languagesManifestFile, JSON.stringify(languagesManifest, null, 2) + '\n');
+console.log('Languages manifest generated');
@@ -53,10 +53,15 @@ func main() {
 	logging.Init()
 
 	slog.Info("starting Satisfactory Mod Manager", slog.String("version", version), slog.String("commit", commit), slog.String("date", date), slog.String("type", updateMode))
+	// The per-domain proxies cannot be passed to webkit, so they only apply to the backend.
+	// This must happen before the transport is wrapped, the proxy settings are read for every request.
+	settings.ApplyProxy()
 	// Set user agent for http requests from backend
 	// We cannot set the frontend's user agent, because wails does not expose that,
 	// but it does append wails.io to determine which asset requests come from inside the app, and which are external
 	http.DefaultTransport = &withUserAgent{inner: http.DefaultTransport}
+	// Counts the session network usage shown to users on metered connections
+	http.DefaultTransport = &utils.NetworkUsageTransport{Inner: http.DefaultTransport}
 
 	autoupdate.Init()
 
@@ -105,7 +110,9 @@ func main() {
 	}
 
 	windowStartState := options.Normal
-	if settings.Settings.Maximized {
+	if settings.Settings.StartupBehavior.StartMinimized {
+		windowStartState = options.Minimised
+	} else if settings.Settings.Maximized {
 		windowStartState = options.Maximised
 	}
 
@@ -121,7 +128,7 @@ func main() {
 	}
 
 	startUpdateFound := false
-	if settings.Settings.UpdateCheckMode == settings.UpdateOnLaunch {
+	if settings.Settings.StartupBehavior.CheckForManagerUpdates && settings.Settings.UpdateCheckMode == settings.UpdateOnLaunch {
 		foundOrError := make(chan bool)
 		autoupdate.Updater.Updater.UpdateFound.Once(func(_ updater.PendingUpdate) {
 			foundOrError <- true
@@ -174,6 +181,8 @@ func main() {
 			go websocket.ListenAndServeWebsocket()
 
 			ficsitcli.FicsitCLI.StartGameRunningWatcher() //nolint:contextcheck
+			ficsitcli.FicsitCLI.StartDownloadCacheEviction()
+			ficsitcli.FicsitCLI.StartCacheStatsReporter()
 		},
 		OnDomReady: func(_ context.Context) {
 			// OnDomReady is called on every refresh
@@ -199,7 +208,12 @@ func main() {
 					}
 				}
 				backend.ProcessArguments(os.Args[1:]) //nolint:contextcheck
-				autoupdate.Updater.CheckInterval(5 * time.Minute)
+				app.App.ApplyStartupBehavior()        //nolint:contextcheck
+				if settings.Settings.StartupBehavior.CheckForManagerUpdates {
+					autoupdate.Updater.CheckInterval(5 * time.Minute)
+				}
+				app.App.StartModAutoUpdater()
+				app.App.WatchProfileModStatus()
 			})()
 		},
 		OnShutdown: func(_ context.Context) {
@@ -217,6 +231,7 @@ func main() {
 			common.AllInstallTypes,
 			common.AllBranches,
 			common.AllLocationTypes,
+			common.AllStores,
 			ficsitcli.AllInstallationStates,
 			ficsitcli.AllActionTypes,
 		},
@@ -310,10 +325,23 @@ func init() {
 	viper.Set("websocket-port", 33642)
 
 	viper.Set("github-release-repo", "satisfactorymodding/SatisfactoryModManager")
+	viper.Set("sml-release-repo", "satisfactorymodding/SatisfactoryModLoader")
+
+	viper.Set("featured-mods-url", "https://raw.githubusercontent.com/satisfactorymodding/SatisfactoryModManager/master/featured-mods.json")
+
+	viper.Set("feature-flags-url", "https://raw.githubusercontent.com/satisfactorymodding/SatisfactoryModManager/master/feature-flags.json")
+
+	viper.Set("news-feed-url", "https://github.com/satisfactorymodding/SatisfactoryModManager/releases.atom")
+
+	// HTML tags kept in mod descriptions, everything else is removed
+	viper.Set("markdown-allowed-html-tags", []string{"br", "img", "details", "summary", "sub", "sup", "kbd"})
 
 	// logging
 
 	viper.Set("log-file", filepath.Join(smmCacheDir, "logs", "SatisfactoryModManager.log"))
+
+	viper.Set("launch-history-file", filepath.Join(smmLocalDir, "launch_history.jsonl"))
+	viper.Set("update-history-file", filepath.Join(smmLocalDir, "update_history.jsonl"))
 }
 
 type withUserAgent struct {